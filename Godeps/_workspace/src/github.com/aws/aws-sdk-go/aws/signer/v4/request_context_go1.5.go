@@ -0,0 +1,14 @@
+//go:build !go1.7
+// +build !go1.7
+
+package v4
+
+import (
+	"net/http"
+
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+)
+
+func requestContext(r *http.Request) aws.Context {
+	return aws.BackgroundContext()
+}
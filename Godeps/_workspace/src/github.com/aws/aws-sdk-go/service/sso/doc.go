@@ -0,0 +1,46 @@
+// Code generated by private/model/cli/gen-api/main.go. DO NOT EDIT.
+
+// Package sso provides the client and types for making API
+// requests to AWS Single Sign-On.
+//
+// Amazon Web Services Single Sign On Portal is a web service that makes it
+// easy for you to assign user access to Amazon Web Services SSO resources such
+// as the AWS access portal. Users can get Amazon Web Services account applications
+// and roles assigned to them and get federated into the application.
+//
+// Although Amazon Web Services Single Sign-On was renamed, the sso and identitystore
+// API namespaces will continue to retain their original name for backward compatibility
+// purposes. For more information, see Amazon Web Services SSO rename (https://docs.aws.amazon.com/singlesignon/latest/userguide/what-is.html#renamed).
+//
+// This API reference guide describes the Amazon Web Services SSO Portal operations
+// that you can call programatically and includes detailed information on data
+// types and errors.
+//
+// Amazon Web Services provides SDKs that consist of libraries and sample code
+// for various programming languages and platforms, such as Java, Ruby, .Net,
+// iOS, or Android. The SDKs provide a convenient way to create programmatic
+// access to Amazon Web Services SSO and other Amazon Web Services services.
+// For more information about the Amazon Web Services SDKs, including how to
+// download and install them, see Tools for Amazon Web Services (http://aws.amazon.com/tools/).
+//
+// See https://docs.aws.amazon.com/goto/WebAPI/sso-2019-06-10 for more information on this service.
+//
+// See sso package documentation for more information.
+// https://docs.aws.amazon.com/sdk-for-go/api/service/sso/
+//
+// Using the Client
+//
+// To contact AWS Single Sign-On with the SDK use the New function to create
+// a new service client. With that client you can make API requests to the service.
+// These clients are safe to use concurrently.
+//
+// See the SDK's documentation for more information on how to use the SDK.
+// https://docs.aws.amazon.com/sdk-for-go/api/
+//
+// See aws.Config documentation for more information on configuring SDK clients.
+// https://docs.aws.amazon.com/sdk-for-go/api/aws/#Config
+//
+// See the AWS Single Sign-On client SSO for more
+// information on creating client for this service.
+// https://docs.aws.amazon.com/sdk-for-go/api/service/sso/#New
+package sso
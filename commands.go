@@ -0,0 +1,1719 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/session"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/jessevdk/go-flags"
+	"github.com/rlister/let-me-in/letmein"
+	"github.com/rlister/let-me-in/simulator"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// cmdline parser and config file, shared by commands
+var parser *flags.Parser
+var cfg *Config
+
+// record of changes made, for crash recovery and undo
+var ledger *letmein.Ledger
+
+// audit log of changes for --audit, opened when first needed
+var auditor *letmein.Auditor
+
+// aws session from AWS_* env vars and shared config, loaded when first needed
+var sess *session.Session
+
+// options describing the permission to grant or revoke
+type PermOptions struct {
+	CidrOptions
+	PolicyOptions
+	ReasonOptions
+	Port     int    `short:"p" long:"port" default:"22" description:"port number to allow"`
+	Protocol string `short:"P" long:"protocol" default:"tcp" description:"protocol to allow: tcp, udp or icmp"`
+}
+
+// options selecting cidr blocks, instead of looking up public ip
+type CidrOptions struct {
+	Cidr          []string `short:"c" long:"cidr" description:"set a specific cidr block, may be repeated (default: current public ip)"`
+	CidrInterface string   `long:"cidr-from-interface" description:"use address of local network interface, e.g. tun0 for a VPN"`
+	CidrDns       []string `long:"cidr-from-dns" description:"use every address a DNS name resolves to, may be repeated"`
+	Family        string   `long:"family" default:"4" choice:"4" choice:"6" choice:"both" description:"address family to detect and use"`
+}
+
+// options recording why access was granted
+type ReasonOptions struct {
+	Reason string `long:"reason" description:"reason for access, recorded in rule description"`
+	Ticket string `long:"ticket" description:"ticket id for access, e.g. OPS-123, recorded in rule description"`
+}
+
+// options for policy limits on cidr blocks
+type PolicyOptions struct {
+	Force bool `long:"force" description:"allow cidr blocks broader than policy"`
+}
+
+// flags for invocation without a subcommand, e.g. let-me-in -r my-sg
+type LegacyOptions struct {
+	List   bool          `short:"l" long:"list" description:"list current rules for security groups"`
+	Revoke bool          `short:"r" long:"revoke" description:"revoke access from security groups"`
+	Clean  bool          `short:"x" long:"clean" description:"clean listed groups, i.e. revoke all access"`
+	Yes    bool          `short:"y" long:"yes" description:"clean without asking for confirmation"`
+	For    time.Duration `long:"for" description:"revoke access again after this duration, e.g. 30m"`
+	PermOptions
+}
+
+type GrantCommand struct {
+	PermOptions
+	For time.Duration `long:"for" description:"revoke access again after this duration, e.g. 30m"`
+}
+
+type RevokeCommand struct {
+	PermOptions
+}
+
+type ListCommand struct{}
+
+type CleanCommand struct {
+	PolicyOptions
+	Yes         bool   `short:"y" long:"yes" description:"do not ask for confirmation"`
+	OnlyPort    int    `long:"only-port" description:"only remove rules for this port"`
+	OnlyPrefix  string `long:"only-prefix" description:"only remove rules for cidrs with this prefix length, e.g. /32"`
+	OnlyMine    bool   `long:"only-mine" description:"only remove rules allowing your public ip"`
+	OnlyLetMeIn bool   `long:"only-let-me-in" description:"only remove rules created by let-me-in"`
+	Family      string `long:"family" default:"4" choice:"4" choice:"6" choice:"both" description:"address family of your ip for --only-mine"`
+}
+
+type ExecCommand struct {
+	PermOptions
+	For time.Duration `long:"for" description:"revoke access after this duration, even if command is still running"`
+}
+
+type SyncCommand struct {
+	PermOptions
+	Source     string `long:"source" description:"URL of ip list to sync to, as JSON or plain text"`
+	JqPath     string `long:"jq-path" description:"path to cidrs in JSON list, e.g. .prefixes[].ip_prefix"`
+	MaxChanges int    `long:"max-changes" default:"20" description:"refuse to sync if it would make more changes than this, 0 for no limit"`
+	DryRun     bool   `short:"n" long:"dry-run" description:"show changes without making them"`
+}
+
+type ApplyCommand struct {
+	PolicyOptions
+	ReasonOptions
+	File   string `short:"f" long:"file" required:"yes" description:"YAML file of rules that should exist"`
+	Prune  bool   `long:"prune" description:"also revoke rules in the listed groups that the file does not mention"`
+	DryRun bool   `short:"n" long:"dry-run" description:"show plan without making changes"`
+}
+
+type DriftCommand struct {
+	Baseline string `long:"baseline" required:"yes" description:"YAML file of approved rules, as for apply"`
+}
+
+// single rule to add to or remove from group by sync or apply
+type change struct {
+	group *ec2.SecurityGroup
+	add   bool
+	input letmein.Input
+}
+
+type StatusCommand struct {
+	CidrOptions
+	PolicyOptions
+	Regions   []string `long:"regions" description:"regions to scan, may be repeated or comma separated (default: --region)"`
+	RevokeAll bool     `long:"revoke-all" description:"revoke every rule found"`
+	Yes       bool     `short:"y" long:"yes" description:"revoke without asking for confirmation"`
+}
+
+type ReapCommand struct {
+	CidrOptions
+	PolicyOptions
+}
+
+type SnapshotCommand struct{}
+
+type RestoreCommand struct {
+	DryRun bool `short:"n" long:"dry-run" description:"show rules to restore without adding them"`
+}
+
+type UndoCommand struct {
+	DryRun bool `short:"n" long:"dry-run" description:"show changes to reverse without making them"`
+}
+
+type ConfigShowCommand struct{}
+
+type IdentServerCommand struct {
+	Listen       string   `long:"listen" default:":8080" description:"address to listen on"`
+	TrustedProxy []string `long:"trust-proxy" description:"cidr of proxy whose X-Forwarded-For header is trusted, may be repeated"`
+}
+
+type SimulateCommand struct {
+	Listen string `long:"listen" default:"127.0.0.1:8000" description:"address to listen on"`
+	State  string `long:"state" description:"YAML file with initial groups, rules and errors to inject"`
+}
+
+// register subcommands with parser
+func addCommands(parser *flags.Parser) {
+	parser.AddCommand("grant", "Grant access to security groups",
+		"Authorize ingress from your public ip, or given cidr, to the listed security groups.", &GrantCommand{})
+	parser.AddCommand("revoke", "Revoke access from security groups",
+		"Revoke ingress from your public ip, or given cidr, from the listed security groups.", &RevokeCommand{})
+	parser.AddCommand("list", "List current rules for security groups",
+		"Print a table of all ip permissions for the listed security groups.", &ListCommand{})
+	parser.AddCommand("clean", "Revoke all access from security groups",
+		"Revoke every ip permission in the listed security groups. The groups will have no ingress at all afterwards.", &CleanCommand{})
+	parser.AddCommand("exec", "Grant access while running a command",
+		"Authorize access, run the command given after '--', then revoke access again when it exits.", &ExecCommand{})
+	parser.AddCommand("sync", "Keep rules for a port in step with a list of cidrs",
+		"Authorize the given cidrs on the port, and revoke rules on that port for any other cidr. Use with --cidr-from-dns to follow the addresses a name resolves to.", &SyncCommand{})
+	parser.AddCommand("apply", "Converge security groups on rules in a file",
+		"Authorize the rules listed in a YAML file that are missing from their groups, and with --prune revoke any other rules in those groups. Groups not in the file are left alone.", &ApplyCommand{})
+	parser.AddCommand("drift", "Compare security groups with a baseline",
+		"Report rules not in the baseline, rules that widen a baseline rule, and baseline rules that are missing, for the groups in a YAML baseline file. Exits non-zero if there is any drift.", &DriftCommand{})
+	parser.AddCommand("status", "Show access held by your ip",
+		"List the rules that allow your public ip, or given cidr, with their age, in the listed security groups or in every group of the selected regions. With --revoke-all, revoke them all.", &StatusCommand{})
+	parser.AddCommand("reap", "Revoke all access held by your ip",
+		"Revoke every rule in the listed security groups that allows your public ip, or given cidr, on any port.", &ReapCommand{})
+	parser.AddCommand("snapshot", "Save rules of security groups",
+		"Save the ingress rules of the listed security groups to a timestamped JSON file. Snapshots are also saved automatically before clean, reap and large revokes.", &SnapshotCommand{})
+	parser.AddCommand("restore", "Restore rules from a snapshot",
+		"Authorize every rule in the given snapshot file, or the latest one, that is missing from its security group. Rules added since are left alone.", &RestoreCommand{})
+
+	parser.AddCommand("undo", "Reverse the last operation",
+		"Revoke the rules the last run of let-me-in authorized, and authorize those it revoked, as recorded in the local ledger.", &UndoCommand{})
+
+	parser.AddCommand("ident-server", "Run an ident service",
+		"Serve callers their own address as plain text, or JSON with ?format=json, so you can self-host ident and point --ident at it.", &IdentServerCommand{})
+	parser.AddCommand("simulate", "Run a local EC2 simulator",
+		"Serve an in-memory EC2 security group API, for demos and testing. Use it with --endpoint.", &SimulateCommand{})
+
+	config, _ := parser.AddCommand("config", "Inspect configuration",
+		"Commands for inspecting the config file.", &struct{}{})
+	config.AddCommand("show", "Show merged configuration",
+		"Print defaults after config file, environment and cmdline are applied, and all recipes.", &ConfigShowCommand{})
+}
+
+// create ec2 client from global options and AWS_* env vars
+func newClient() ec2iface.EC2API {
+	return newRegionClient(opt.Region)
+}
+
+// create ec2 client for region, or the default region if empty
+func newRegionClient(region string) ec2iface.EC2API {
+	config := &aws.Config{}
+	if region != "" {
+		config.Region = aws.String(region)
+	}
+	if opt.Endpoint != "" {
+		config.Endpoint = aws.String(opt.Endpoint)
+		if region == "" {
+			config.Region = aws.String("us-east-1") // needed for signing, even if endpoint does not care
+		}
+	}
+
+	var client ec2iface.EC2API = ec2.New(awsSession(), config)
+	if cfg != nil {
+		client = letmein.NewGuardClient(client, cfg.Policy)
+	}
+	if ledger != nil {
+		if ledger.Mode == "" {
+			ledger.Mode = activeCommand()
+		}
+		client = letmein.NewLedgerClient(client, ledger, region)
+	}
+	if a := openAuditor(); a != nil {
+		client = letmein.NewAuditClient(client, a, region)
+	}
+	return client
+}
+
+// shared aws session; exits if env vars or shared config are invalid
+func awsSession() *session.Session {
+	if sess != nil {
+		return sess
+	}
+
+	var err error
+	sess, err = session.NewSession()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "let-me-in: %v\n", err)
+		os.Exit(1)
+	}
+	return sess
+}
+
+// auditor writing to the sink given by --audit, or nil if none; exits if
+// the sink cannot be opened, so no change goes unaudited
+func openAuditor() *letmein.Auditor {
+	if auditor != nil || opt.Audit == "" {
+		return auditor
+	}
+
+	w, err := letmein.OpenAuditSink(opt.Audit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "let-me-in: cannot open audit log: %v\n", err)
+		os.Exit(1)
+	}
+
+	host, _ := os.Hostname()
+	auditor = letmein.NewAuditor(w, currentUser(), strings.Split(host, ".")[0])
+	auditor.Command = activeCommand()
+	if ledger != nil {
+		auditor.Run, auditor.Command = ledger.Run, ledger.Mode
+	}
+	auditor.Warn = func(err error) {
+		fmt.Fprintf(os.Stderr, "let-me-in: warning: audit event not written: %v\n", err)
+	}
+	return auditor
+}
+
+// log the end of a clean or exec session, if auditing
+func auditSession(event letmein.AuditEvent, start time.Time, err error) {
+	if a := openAuditor(); a != nil {
+		if err := a.Session(event, start, err); err != nil {
+			a.Warn(err)
+		}
+	}
+}
+
+// ids of groups
+func groupIds(groups []*ec2.SecurityGroup) []string {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		ids[i] = aws.StringValue(group.GroupId)
+	}
+	return ids
+}
+
+// ledger file in state dir
+func ledgerPath() string {
+	return filepath.Join(stateDir(), "ledger.jsonl")
+}
+
+// name of the subcommand being run
+func activeCommand() string {
+	cmd := parser.Command
+	for cmd.Active != nil {
+		cmd = cmd.Active
+	}
+	return cmd.Name
+}
+
+// true if named option was given for the active command
+func isSet(name string) bool {
+	cmd := parser.Command
+	for cmd.Active != nil {
+		cmd = cmd.Active
+	}
+
+	option := cmd.FindOptionByLongName(name)
+	return option != nil && option.IsSet()
+}
+
+// get cidrs to use: those given, or our public ip if none
+func (c *CidrOptions) cidrs(ctx context.Context) ([]string, error) {
+	cidrs, err := c.given(ctx)
+	if err != nil || len(cidrs) > 0 {
+		return cidrs, err
+	}
+
+	family, err := letmein.ParseFamily(c.Family)
+	if err != nil {
+		return nil, err
+	}
+	return myCidrs(ctx, family)
+}
+
+// get cidrs given by options, normalized
+func (c *CidrOptions) given(ctx context.Context) ([]string, error) {
+	family, err := letmein.ParseFamily(c.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrs := []string{}
+	for _, s := range c.Cidr {
+		cidr, normalized, err := letmein.ParseCidr(s)
+		if err != nil {
+			return nil, err
+		}
+		if normalized {
+			fmt.Fprintf(os.Stderr, "let-me-in: warning: %v has host bits set, using %v\n", s, cidr)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	if c.CidrInterface != "" {
+		found, err := letmein.InterfaceCidrs(c.CidrInterface, family)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, found...)
+	}
+
+	for _, name := range c.CidrDns {
+		found, err := letmein.ResolveCidrs(ctx, name, family)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, found...)
+	}
+
+	return cidrs, nil
+}
+
+// look up our public ip for each address family
+func myCidrs(ctx context.Context, family letmein.Family) ([]string, error) {
+	cidrs := []string{}
+	for _, f := range []letmein.Family{letmein.IPv4, letmein.IPv6} {
+		if family&f == 0 {
+			continue
+		}
+
+		detector := &letmein.Detector{
+			Sources: opt.Ident,
+			Quorum:  opt.IdentQuorum,
+			Timeout: opt.IdentTimeout,
+			IPv6:    f == letmein.IPv6,
+		}
+		if detector.IPv6 {
+			detector.Sources = opt.Ident6
+		}
+		if !opt.NoMetadata {
+			detector.Metadata = letmein.NewMetadataClient(opt.Metadata, 0)
+		}
+
+		ip, err := detector.Detect(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cidr, _, err := letmein.ParseCidr(ip.String())
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
+
+// requested permissions from options, one for each cidr
+func (p *PermOptions) inputs(ctx context.Context) ([]letmein.Input, error) {
+	cidrs, err := p.cidrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.inputsFor(cidrs), nil
+}
+
+// permissions for port and protocol from options, one for each of cidrs
+func (p *PermOptions) inputsFor(cidrs []string) []letmein.Input {
+	inputs := make([]letmein.Input, len(cidrs))
+	for i, cidr := range cidrs {
+		inputs[i] = letmein.NewInput(p.Protocol, int64(p.Port), cidr)
+		inputs[i].Description = aws.String(p.grant().Description())
+	}
+	return inputs
+}
+
+// refuse access to groups that require a reason or ticket without one, or
+// if those given do not match policy
+func (r *ReasonOptions) checkReason(targets []*Target) error {
+	for _, target := range targets {
+		for _, group := range target.Groups {
+			if err := r.checkGroupReason(group); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// as checkReason, for a single group
+func (r *ReasonOptions) checkGroupReason(group *ec2.SecurityGroup) error {
+	err := cfg.Policy.CheckReason(group, r.Reason, r.Ticket)
+	if err != nil && r.Reason == "" && r.Ticket == "" {
+		return fmt.Errorf("%v, use --reason or --ticket", err)
+	}
+	return err
+}
+
+// ownership of rules granted now
+func (r *ReasonOptions) grant() letmein.Grant {
+	host, _ := os.Hostname()
+	return letmein.Grant{
+		User:   currentUser(),
+		Host:   strings.Split(host, ".")[0],
+		Time:   time.Now(),
+		Ticket: r.Ticket,
+		Reason: r.Reason,
+	}
+}
+
+// name of local user: $USER, or from the system
+func currentUser() string {
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return "unknown"
+}
+
+// expand names and recipes into targets and look up their security groups;
+// also returns any duration limit set by recipes
+func findTargets(ctx context.Context, client ec2iface.EC2API, names []string, inputs []letmein.Input) ([]*Target, time.Duration, error) {
+	if len(names) == 0 {
+		return nil, 0, fmt.Errorf("no security groups given")
+	}
+
+	targets, limit, err := expandTargets(cfg, names, opt.Filter, inputs, isSet("port"))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, target := range targets {
+		target.Groups, err = letmein.GetGroups(ctx, client, target.Names, target.Filter)
+		if err != nil {
+			return nil, 0, err // if AWS creds not configured, report it here
+		}
+	}
+
+	return targets, limit, nil
+}
+
+// refuse to change rules with cidrs broader than policy, unless forced
+func (p *PolicyOptions) check(targets []*Target) error {
+	if p.Force {
+		return nil
+	}
+
+	for _, target := range targets {
+		for _, input := range target.Inputs {
+			if err := cfg.Policy.Check(input); err != nil {
+				return fmt.Errorf("%v, use --force to override", err)
+			}
+		}
+	}
+	return nil
+}
+
+// refuse to revoke rules for protected cidrs, even if forced
+func protect(targets []*Target) error {
+	for _, target := range targets {
+		for _, input := range target.Inputs {
+			if cfg.Policy.Protects(input.Cidr()) {
+				return fmt.Errorf("cidr %v is protected by policy, refusing to revoke", input.Cidr())
+			}
+		}
+	}
+	return nil
+}
+
+// refuse to change groups that have not opted in, or whose tags do not
+// allow the ports of targets, before anything is changed; returns limit
+// lowered to the shortest max duration tagged on the groups
+func guard(targets []*Target, limit time.Duration) (time.Duration, error) {
+	for _, target := range targets {
+		for _, group := range target.Groups {
+			for _, input := range target.Inputs {
+				if err := cfg.Policy.CheckGroupInput(group, input); err != nil {
+					return 0, err
+				}
+			}
+			d, err := letmein.MaxDuration(group)
+			if err != nil {
+				return 0, err
+			}
+			if d > 0 && (limit == 0 || d < limit) {
+				limit = d
+			}
+		}
+	}
+	return limit, nil
+}
+
+// refuse to remove rules from groups that have not opted in
+func guardGroups(groups []*ec2.SecurityGroup) error {
+	for _, group := range groups {
+		if err := cfg.Policy.CheckGroup(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groups with only the rules policy allows us to remove, warning about the
+// rest; protected rules are kept even if forced
+func (p *PolicyOptions) removable(groups []*ec2.SecurityGroup) []*ec2.SecurityGroup {
+	unprotected := make([]*ec2.SecurityGroup, len(groups))
+	for i, group := range groups {
+		unprotected[i] = letmein.FilterGroups(groups[i:i+1], func(perm *ec2.IpPermission, cidr string) bool {
+			if cfg.Policy.Protects(cidr) {
+				fmt.Fprintf(os.Stderr, "let-me-in: keeping %v %v %v %v, protected by policy\n",
+					aws.StringValue(group.GroupName), aws.StringValue(perm.IpProtocol), cidr, aws.Int64Value(perm.FromPort))
+				return false
+			}
+			return true
+		})[0]
+	}
+	groups = unprotected
+
+	if p.Force {
+		return groups
+	}
+
+	allowed, refused := cfg.Policy.Split(groups)
+	for _, group := range refused {
+		for _, perm := range group.IpPermissions {
+			for _, cidr := range letmein.Ranges(perm) {
+				fmt.Fprintf(os.Stderr, "let-me-in: keeping %v %v %v %v, broader than policy allows removing (use --force)\n",
+					aws.StringValue(group.GroupName), aws.StringValue(perm.IpProtocol), cidr, aws.Int64Value(perm.FromPort))
+			}
+		}
+	}
+	return allowed
+}
+
+// revokes of at least this many rules save a snapshot first
+const largeRevoke = 5
+
+// directory of snapshots in state dir
+func snapshotDir() string {
+	return filepath.Join(stateDir(), "snapshots")
+}
+
+// security groups of all targets
+func targetGroups(targets []*Target) []*ec2.SecurityGroup {
+	groups := []*ec2.SecurityGroup{}
+	for _, target := range targets {
+		groups = append(groups, target.Groups...)
+	}
+	return groups
+}
+
+// save rules of groups in region before removing any, so they can be restored
+func saveSnapshot(groups []*ec2.SecurityGroup, region string) error {
+	path, err := letmein.WriteSnapshot(snapshotDir(), letmein.NewSnapshot(groups, region))
+	if err != nil {
+		return fmt.Errorf("could not save snapshot, nothing revoked: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "let-me-in: saved rules to %v, undo with: let-me-in restore %v\n", path, path)
+	return nil
+}
+
+// true if policy allows removing rule for input from group, warning if not
+func (p *PolicyOptions) canRemove(group *ec2.SecurityGroup, input letmein.Input) bool {
+	name, protocol, port := aws.StringValue(group.GroupName), aws.StringValue(input.IpProtocol), aws.Int64Value(input.FromPort)
+	if cfg.Policy.Protects(input.Cidr()) {
+		fmt.Fprintf(os.Stderr, "let-me-in: keeping %v %v %v %v, protected by policy\n", name, protocol, input.Cidr(), port)
+		return false
+	}
+	if err := cfg.Policy.Check(input); err != nil && !p.Force {
+		fmt.Fprintf(os.Stderr, "let-me-in: keeping %v %v %v %v, broader than policy allows removing (use --force)\n", name, protocol, input.Cidr(), port)
+		return false
+	}
+	return true
+}
+
+// apply limit set by recipes to requested duration
+func limitDuration(d, limit time.Duration) time.Duration {
+	if limit > 0 && (d == 0 || d > limit) {
+		return limit
+	}
+	return d
+}
+
+func authorizeTargets(ctx context.Context, client ec2iface.EC2API, targets []*Target) error {
+	for _, target := range targets {
+		for _, input := range target.Inputs {
+			if err := letmein.AuthorizeGroups(ctx, client, target.Groups, input); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func revokeTargets(ctx context.Context, client ec2iface.EC2API, targets []*Target) error {
+	for _, target := range targets {
+		for _, input := range target.Inputs {
+			if err := letmein.RevokeGroups(ctx, client, target.Groups, input); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// block until duration has passed or we are interrupted
+func waitFor(d time.Duration) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	defer signal.Stop(sig)
+
+	select {
+	case <-time.After(d):
+	case <-sig:
+	}
+}
+
+func (c *GrantCommand) Execute(args []string) error {
+	ctx := context.Background()
+	inputs, err := c.inputs(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := newClient()
+	targets, limit, err := findTargets(ctx, client, args, inputs)
+	if err != nil {
+		return err
+	}
+	if err := c.check(targets); err != nil {
+		return err
+	}
+	if err := c.checkReason(targets); err != nil {
+		return err
+	}
+	if limit, err = guard(targets, limit); err != nil {
+		return err
+	}
+
+	if err := authorizeTargets(ctx, client, targets); err != nil {
+		return err
+	}
+
+	// hold access for requested duration, then revoke
+	if d := limitDuration(c.For, limit); d > 0 {
+		fmt.Printf("access granted for %v, interrupt to revoke early\n", d)
+		waitFor(d)
+		return revokeTargets(ctx, client, targets)
+	}
+
+	return nil
+}
+
+func (c *RevokeCommand) Execute(args []string) error {
+	ctx := context.Background()
+	inputs, err := c.inputs(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := newClient()
+	targets, _, err := findTargets(ctx, client, args, inputs)
+	if err != nil {
+		return err
+	}
+	if err := c.check(targets); err != nil {
+		return err
+	}
+	if err := protect(targets); err != nil {
+		return err
+	}
+	if err := guardGroups(targetGroups(targets)); err != nil {
+		return err
+	}
+
+	count := 0
+	for _, target := range targets {
+		count += len(target.Inputs) * len(target.Groups)
+	}
+	if count >= largeRevoke {
+		if err := saveSnapshot(targetGroups(targets), opt.Region); err != nil {
+			return err
+		}
+	}
+
+	return revokeTargets(ctx, client, targets)
+}
+
+func (c *ListCommand) Execute(args []string) error {
+	ctx := context.Background()
+	targets, _, err := findTargets(ctx, newClient(), args, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		printIpRanges(target.Groups)
+	}
+	return nil
+}
+
+func (c *CleanCommand) Execute(args []string) error {
+	ctx := context.Background()
+	keep, err := c.scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := newClient()
+	targets, _, err := findTargets(ctx, client, args, nil)
+	if err != nil {
+		return err
+	}
+	if err := guardGroups(targetGroups(targets)); err != nil {
+		return err
+	}
+
+	// rules to remove from each target
+	clean := make([][]*ec2.SecurityGroup, len(targets))
+	count := 0
+	for i, target := range targets {
+		clean[i] = c.removable(letmein.FilterGroups(target.Groups, keep))
+		printIpRanges(clean[i])
+		count += countRules(clean[i])
+	}
+
+	if count == 0 {
+		fmt.Println("no rules to revoke")
+		return nil
+	}
+	if !c.Yes && !confirm(fmt.Sprintf("revoke these %d rules?", count)) {
+		return fmt.Errorf("clean not confirmed, nothing revoked")
+	}
+	if err := saveSnapshot(targetGroups(targets), opt.Region); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	cleaned := []*ec2.SecurityGroup{}
+	for _, groups := range clean {
+		for _, group := range groups {
+			if len(group.IpPermissions) > 0 {
+				cleaned = append(cleaned, group)
+			}
+		}
+	}
+	err = letmein.CleanGroups(ctx, client, cleaned)
+	auditSession(letmein.AuditEvent{Event: letmein.EventClean, Groups: groupIds(cleaned), Rules: count}, start, err)
+	return err
+}
+
+// test for the rules clean is limited to by --only-* options
+func (c *CleanCommand) scope(ctx context.Context) (func(*ec2.IpPermission, string) bool, error) {
+	prefix := -1
+	if c.OnlyPrefix != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(c.OnlyPrefix, "/"))
+		if err != nil || n < 0 || n > 128 {
+			return nil, fmt.Errorf("invalid prefix length %q for --only-prefix", c.OnlyPrefix)
+		}
+		prefix = n
+	}
+
+	mine := map[string]bool{}
+	if c.OnlyMine {
+		family, err := letmein.ParseFamily(c.Family)
+		if err != nil {
+			return nil, err
+		}
+		cidrs, err := myCidrs(ctx, family)
+		if err != nil {
+			return nil, err
+		}
+		for _, cidr := range cidrs {
+			mine[cidr] = true
+		}
+	}
+
+	return func(perm *ec2.IpPermission, cidr string) bool {
+		switch {
+		case c.OnlyPort != 0 && (int64(c.OnlyPort) < aws.Int64Value(perm.FromPort) || int64(c.OnlyPort) > aws.Int64Value(perm.ToPort)):
+			return false
+		case prefix >= 0 && !strings.HasSuffix(cidr, "/"+strconv.Itoa(prefix)):
+			return false
+		case c.OnlyMine && !mine[cidr]:
+			return false
+		case c.OnlyLetMeIn && !letmein.IsLetMeIn(letmein.RangeDescription(perm, cidr)):
+			return false
+		}
+		return true
+	}, nil
+}
+
+// number of ip ranges in groups
+func countRules(groups []*ec2.SecurityGroup) int {
+	count := 0
+	for _, group := range groups {
+		for _, perm := range group.IpPermissions {
+			count += len(letmein.Ranges(perm))
+		}
+	}
+	return count
+}
+
+// ask a yes/no question on stdin, defaulting to no
+func confirm(question string) bool {
+	fmt.Printf("%v [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
+func (c *ExecCommand) Execute(args []string) error {
+	if len(execArgs) == 0 {
+		return fmt.Errorf("no command given after '--'")
+	}
+
+	ctx := context.Background()
+	if err := recoverCrashed(ctx); err != nil {
+		return err
+	}
+
+	inputs, err := c.inputs(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := newClient()
+	targets, limit, err := findTargets(ctx, client, args, inputs)
+	if err != nil {
+		return err
+	}
+	if err := c.check(targets); err != nil {
+		return err
+	}
+	if err := c.checkReason(targets); err != nil {
+		return err
+	}
+	if limit, err = guard(targets, limit); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	if err := authorizeTargets(ctx, client, targets); err != nil {
+		return err
+	}
+
+	cmd := exec.Command(execArgs[0], execArgs[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	// with a time limit, revoke when it expires even if command is still running
+	done := make(chan struct{})
+	if d := limitDuration(c.For, limit); d > 0 {
+		go func() {
+			select {
+			case <-time.After(d):
+				fmt.Fprintf(os.Stderr, "let-me-in: access expired after %v\n", d)
+				if err := revokeTargets(ctx, client, targets); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+				}
+			case <-done:
+			}
+		}()
+	}
+
+	runErr := cmd.Run()
+	close(done)
+	if runErr != nil {
+		fmt.Println(runErr) // show err and keep running so we hit revoke below
+	}
+
+	err = revokeTargets(ctx, client, targets)
+	session := letmein.AuditEvent{Event: letmein.EventExec, Groups: groupIds(targetGroups(targets)), Exec: execArgs}
+	for _, target := range targets {
+		session.Rules += len(target.Inputs) * len(target.Groups)
+	}
+	if runErr != nil {
+		auditSession(session, start, runErr)
+	} else {
+		auditSession(session, start, err)
+	}
+	return err
+}
+
+// offer to revoke rules granted by exec runs that died without revoking
+// them, as found in the ledger
+func recoverCrashed(ctx context.Context) error {
+	entries, err := letmein.ReadLedger(ledgerPath())
+	if err != nil {
+		return err
+	}
+
+	crashed := []letmein.LedgerEntry{}
+	for _, e := range letmein.Outstanding(entries) {
+		if e.Mode == "exec" && e.Pid != os.Getpid() && !processAlive(e.Pid) {
+			crashed = append(crashed, e)
+		}
+	}
+	if len(crashed) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stderr, "let-me-in: rules left by exec runs that did not finish:")
+	for _, e := range crashed {
+		fmt.Fprintf(os.Stderr, "  %v\t%v\t%v\t%v\t%v\n", e.GroupId, e.Protocol, e.Cidr, e.FromPort, e.Time.Local().Format(time.RFC1123))
+	}
+	if !confirm(fmt.Sprintf("revoke these %d rules?", len(crashed))) {
+		return nil
+	}
+
+	// record revokes as undoing the crashed run, so a later undo neither
+	// picks that run nor re-opens what it left
+	if ledger != nil {
+		defer func(undoes string) { ledger.Undoes = undoes }(ledger.Undoes)
+	}
+	for _, e := range crashed {
+		if ledger != nil {
+			ledger.Undoes = e.Run
+		}
+		group := &ec2.SecurityGroup{GroupId: aws.String(e.GroupId)}
+		if err := letmein.RevokeGroup(ctx, newRegionClient(e.Region), group, e.Input()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *SyncCommand) Execute(args []string) error {
+	ctx := context.Background()
+	cidrs, err := c.given(ctx)
+	if err != nil {
+		return err
+	}
+
+	if c.Source != "" {
+		fetched, err := c.fetch(ctx)
+		if err != nil {
+			return err
+		}
+		cidrs = append(cidrs, fetched...)
+	} else if len(cidrs) == 0 {
+		// syncing to our own ip would revoke everyone else
+		return fmt.Errorf("sync needs cidrs from --source, --cidr, --cidr-from-dns or --cidr-from-interface")
+	}
+
+	if len(cidrs) == 0 {
+		return fmt.Errorf("no cidrs to sync to, refusing to revoke every rule")
+	}
+
+	family, err := c.family(cidrs)
+	if err != nil {
+		return err
+	}
+
+	// mark rules we add with where they came from, so only those are
+	// removed by later syncs from the same source
+	source := c.source()
+	inputs := c.inputsFor(cidrs)
+	grant := c.grant()
+	grant.Sync = source
+	for i := range inputs {
+		inputs[i].Description = aws.String(grant.Description())
+	}
+
+	client := newClient()
+	targets, _, err := findTargets(ctx, client, args, inputs)
+	if err != nil {
+		return err
+	}
+	if err := c.check(targets); err != nil {
+		return err
+	}
+	if err := c.checkReason(targets); err != nil {
+		return err
+	}
+	if _, err := guard(targets, 0); err != nil {
+		return err
+	}
+
+	changes := c.plan(targets, source, family)
+	if c.MaxChanges > 0 && len(changes) > c.MaxChanges {
+		return fmt.Errorf("sync would make %d changes, more than --max-changes %d", len(changes), c.MaxChanges)
+	}
+
+	return makeChanges(ctx, client, targetGroups(targets), changes, c.DryRun)
+}
+
+// print changes and make them, unless a dry run; groups are saved first if
+// there are many removals
+func makeChanges(ctx context.Context, client ec2iface.EC2API, groups []*ec2.SecurityGroup, changes []change, dryRun bool) error {
+	removals := 0
+	for _, ch := range changes {
+		if !ch.add {
+			removals++
+		}
+	}
+	if removals >= largeRevoke && !dryRun {
+		if err := saveSnapshot(groups, opt.Region); err != nil {
+			return err
+		}
+	}
+
+	// show the whole plan before changing anything
+	for _, ch := range changes {
+		fmt.Println(ch)
+	}
+	if dryRun {
+		fmt.Fprintf(os.Stderr, "let-me-in: dry run, %d changes not made\n", len(changes))
+		return nil
+	}
+
+	for i, ch := range changes {
+		var err error
+		if ch.add {
+			err = letmein.AuthorizeGroup(ctx, client, ch.group, ch.input)
+		} else {
+			err = letmein.RevokeGroup(ctx, client, ch.group, ch.input)
+		}
+		if err != nil {
+			return fmt.Errorf("made %d of %d changes, failed at %v: %v", i, len(changes), ch, err)
+		}
+	}
+	return nil
+}
+
+// change as shown in plans, e.g. "+ web	tcp	203.0.113.1/32	22"
+func (ch change) String() string {
+	sign := "-"
+	if ch.add {
+		sign = "+"
+	}
+	return fmt.Sprintf("%v %v\t%v\t%v\t%v", sign, aws.StringValue(ch.group.GroupName), aws.StringValue(ch.input.IpProtocol), ch.input.Cidr(), portRange(ch.input))
+}
+
+// fetch cidrs of selected address family from source url
+func (c *SyncCommand) fetch(ctx context.Context) ([]string, error) {
+	family, err := letmein.ParseFamily(c.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	fetched, err := letmein.FetchCidrs(ctx, c.Source, c.JqPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrs := []string{}
+	for _, cidr := range fetched {
+		if letmein.IsIPv6(cidr) && family&letmein.IPv6 != 0 || !letmein.IsIPv6(cidr) && family&letmein.IPv4 != 0 {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs, nil
+}
+
+// name of what we sync to, recorded in rules we add: the --source url, or
+// the names and interface given for cidrs
+func (c *SyncCommand) source() string {
+	if c.Source != "" {
+		return c.Source
+	}
+	names := append([]string{}, c.CidrDns...)
+	if c.CidrInterface != "" {
+		names = append(names, c.CidrInterface)
+	}
+	if len(c.Cidr) > 0 {
+		names = append(names, "cidr")
+	}
+	return strings.Join(names, ",")
+}
+
+// address families to sync: --family, and that of any cidr given
+func (c *SyncCommand) family(cidrs []string) (letmein.Family, error) {
+	family, err := letmein.ParseFamily(c.Family)
+	if err != nil {
+		return 0, err
+	}
+	for _, cidr := range cidrs {
+		if letmein.IsIPv6(cidr) {
+			family |= letmein.IPv6
+		} else {
+			family |= letmein.IPv4
+		}
+	}
+	return family, nil
+}
+
+// changes needed to bring groups in line, removing only rules an earlier
+// sync from source added, in family, and that policy allows removing
+func (c *SyncCommand) plan(targets []*Target, source string, family letmein.Family) []change {
+	changes := []change{}
+	for _, target := range targets {
+		ports, cidrs := byPort(target.Inputs)
+		for _, group := range target.Groups {
+			for i, port := range ports {
+				add, remove := letmein.SyncDiff(group, port, cidrs[i], source, family)
+				for _, input := range add {
+					changes = append(changes, change{group, true, input})
+				}
+				for _, input := range remove {
+					if c.canRemove(group, input) {
+						changes = append(changes, change{group, false, input})
+					}
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// distinct protocol and ports of inputs, with the cidrs wanted on each
+func byPort(inputs []letmein.Input) ([]letmein.Input, [][]string) {
+	ports := []letmein.Input{}
+	cidrs := [][]string{}
+	index := map[string]int{}
+
+	for _, input := range inputs {
+		key := fmt.Sprintf("%v/%v-%v", aws.StringValue(input.IpProtocol), aws.Int64Value(input.FromPort), aws.Int64Value(input.ToPort))
+		i, ok := index[key]
+		if !ok {
+			i = len(ports)
+			index[key] = i
+			ports = append(ports, input)
+			cidrs = append(cidrs, nil)
+		}
+		cidrs[i] = append(cidrs[i], input.Cidr())
+	}
+	return ports, cidrs
+}
+
+func (c *ApplyCommand) Execute(args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("apply takes groups from the file, not the cmdline")
+	}
+
+	rules, err := letmein.LoadRuleSet(c.File)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client := newClient()
+	groups := []*ec2.SecurityGroup{}
+	changes := []change{}
+
+	for _, g := range rules.Groups {
+		found, err := findRuleGroups(ctx, client, g)
+		if err != nil {
+			return err
+		}
+
+		inputs := g.Inputs()
+		for _, group := range found {
+			add, remove := letmein.ApplyDiff(group, inputs, c.Prune)
+			if len(add) > 0 {
+				if err := c.checkGroupReason(group); err != nil {
+					return err
+				}
+			}
+			if len(remove) > 0 {
+				if err := cfg.Policy.CheckGroup(group); err != nil {
+					return err
+				}
+			}
+			for _, input := range add {
+				if err := cfg.Policy.Check(input); err != nil && !c.Force {
+					return fmt.Errorf("group %v: %v, use --force to override", g, err)
+				}
+				if err := cfg.Policy.CheckGroupInput(group, input); err != nil {
+					return err
+				}
+				changes = append(changes, change{group, true, input})
+			}
+			for _, input := range remove {
+				if c.canRemove(group, input) {
+					changes = append(changes, change{group, false, input})
+				}
+			}
+		}
+		groups = append(groups, found...)
+	}
+
+	if len(changes) == 0 {
+		fmt.Fprintln(os.Stderr, "let-me-in: no changes, groups match rules")
+		return nil
+	}
+
+	// rules keep the descriptions from the file, so only audit records why
+	if a := openAuditor(); a != nil {
+		a.Ticket, a.Reason = c.Ticket, c.Reason
+	}
+	return makeChanges(ctx, client, groups, changes, c.DryRun)
+}
+
+// look up security groups for rules by id, or by name
+func findRuleGroups(ctx context.Context, client ec2iface.EC2API, g *letmein.GroupRules) ([]*ec2.SecurityGroup, error) {
+	var groups []*ec2.SecurityGroup
+	var err error
+	if g.Id != "" {
+		groups, err = letmein.GetGroups(ctx, client, []string{g.Id}, "group-id")
+	} else {
+		groups, err = letmein.GetGroups(ctx, client, []string{g.Name}, "group-name")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("security group %v not found", g)
+	}
+	return groups, nil
+}
+
+func (c *DriftCommand) Execute(args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("drift takes groups from the baseline, not the cmdline")
+	}
+
+	baseline, err := letmein.LoadRuleSet(c.Baseline)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client := newClient()
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	count := 0
+	for _, g := range baseline.Groups {
+		groups, err := findRuleGroups(ctx, client, g)
+		if err != nil {
+			return err
+		}
+
+		for _, group := range groups {
+			for _, drift := range letmein.DriftDiff(group, g.Inputs()) {
+				note := ""
+				switch {
+				case drift.Baseline != nil:
+					note = fmt.Sprintf("covers %v %v", drift.Baseline.Cidr(), portRange(*drift.Baseline))
+				case drift.Kind == letmein.DriftExtra && letmein.IsLetMeIn(aws.StringValue(drift.Input.Description)):
+					note = "left by let-me-in"
+				}
+				fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n", drift.Kind, aws.StringValue(group.GroupName),
+					aws.StringValue(drift.Input.IpProtocol), drift.Input.Cidr(), portRange(drift.Input), note)
+				count++
+			}
+		}
+	}
+	w.Flush()
+
+	if count > 0 {
+		return fmt.Errorf("%d rules drifted from baseline %v", count, c.Baseline)
+	}
+	return nil
+}
+
+// port of input, its range of ports, or all for all traffic
+func portRange(input letmein.Input) string {
+	if aws.StringValue(input.IpProtocol) == "-1" {
+		return "all"
+	}
+	from, to := aws.Int64Value(input.FromPort), aws.Int64Value(input.ToPort)
+	if from == to {
+		return strconv.FormatInt(from, 10)
+	}
+	return fmt.Sprintf("%v-%v", from, to)
+}
+
+func (c *StatusCommand) Execute(args []string) error {
+	ctx := context.Background()
+	cidrs, err := c.cidrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	regions := splitList(strings.Join(c.Regions, ","))
+	if len(regions) == 0 {
+		regions = []string{opt.Region}
+	}
+
+	granted, err := grantTimes()
+	if err != nil {
+		return err
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	now := time.Now()
+
+	// matching rules in each region, for --revoke-all
+	clients := make([]ec2iface.EC2API, len(regions))
+	found := make([][]*ec2.SecurityGroup, len(regions))
+	count := 0
+
+	for i, region := range regions {
+		clients[i] = newRegionClient(region)
+
+		var groups []*ec2.SecurityGroup
+		if len(args) > 0 {
+			targets, _, err := findTargets(ctx, clients[i], args, nil)
+			if err != nil {
+				return err
+			}
+			groups = targetGroups(targets)
+		} else {
+			groups, err = letmein.AllGroups(ctx, clients[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		found[i] = letmein.MatchingGroups(groups, cidrs...)
+		for _, group := range found[i] {
+			for _, perm := range group.IpPermissions {
+				for _, cidr := range letmein.Ranges(perm) {
+					if len(c.Regions) > 0 {
+						fmt.Fprintf(w, "%v\t", region)
+					}
+					fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n", aws.StringValue(group.GroupName), aws.StringValue(group.GroupId),
+						aws.StringValue(perm.IpProtocol), cidr, aws.Int64Value(perm.FromPort), ruleAge(granted[grantKey(region, group.GroupId, perm, cidr)], perm, cidr, now))
+					count++
+				}
+			}
+		}
+	}
+	w.Flush()
+
+	if !c.RevokeAll || count == 0 {
+		return nil
+	}
+	if !c.Yes && !confirm(fmt.Sprintf("revoke these %d rules?", count)) {
+		return fmt.Errorf("revoke not confirmed, nothing revoked")
+	}
+
+	for i, groups := range found {
+		groups = c.removable(optedIn(groups))
+		if countRules(groups) == 0 {
+			continue
+		}
+		if err := saveSnapshot(groups, regions[i]); err != nil {
+			return err
+		}
+		if err := letmein.CleanGroups(ctx, clients[i], groups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groups policy allows changing, warning about the rest
+func optedIn(groups []*ec2.SecurityGroup) []*ec2.SecurityGroup {
+	allowed := []*ec2.SecurityGroup{}
+	for _, group := range groups {
+		if err := cfg.Policy.CheckGroup(group); err != nil {
+			fmt.Fprintf(os.Stderr, "let-me-in: %v\n", err)
+			continue
+		}
+		allowed = append(allowed, group)
+	}
+	return allowed
+}
+
+// age of rule from the time recorded in its description when let-me-in
+// granted it, or else the time from the ledger, or - if unknown
+func ruleAge(logged time.Time, perm *ec2.IpPermission, cidr string, now time.Time) string {
+	if grant, ok := letmein.ParseGrant(letmein.RangeDescription(perm, cidr)); ok && !grant.Time.IsZero() {
+		return formatAge(now.Sub(grant.Time))
+	}
+	if !logged.IsZero() {
+		return formatAge(now.Sub(logged))
+	}
+	return "-"
+}
+
+// times of rules granted and not revoked since, by grantKey, from the ledger
+func grantTimes() (map[string]time.Time, error) {
+	entries, err := letmein.ReadLedger(ledgerPath())
+	if err != nil {
+		return nil, err
+	}
+
+	times := map[string]time.Time{}
+	for _, e := range letmein.Outstanding(entries) {
+		perm := &ec2.IpPermission{IpProtocol: aws.String(e.Protocol), FromPort: aws.Int64(e.FromPort), ToPort: aws.Int64(e.ToPort)}
+		times[grantKey(e.Region, aws.String(e.GroupId), perm, e.Cidr)] = e.Time
+	}
+	return times, nil
+}
+
+// identity of a rule across regions
+func grantKey(region string, groupId *string, perm *ec2.IpPermission, cidr string) string {
+	return fmt.Sprintf("%v/%v/%v/%v-%v/%v", region, aws.StringValue(groupId), aws.StringValue(perm.IpProtocol),
+		aws.Int64Value(perm.FromPort), aws.Int64Value(perm.ToPort), cidr)
+}
+
+// short human duration, e.g. 3d4h, 2h5m or 40s
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
+
+func (c *ReapCommand) Execute(args []string) error {
+	ctx := context.Background()
+	cidrs, err := c.cidrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := newClient()
+	targets, _, err := findTargets(ctx, client, args, nil)
+	if err != nil {
+		return err
+	}
+	if err := guardGroups(targetGroups(targets)); err != nil {
+		return err
+	}
+
+	reap := make([][]*ec2.SecurityGroup, len(targets))
+	count := 0
+	for i, target := range targets {
+		reap[i] = c.removable(letmein.MatchingGroups(target.Groups, cidrs...))
+		count += countRules(reap[i])
+	}
+	if count == 0 {
+		return nil
+	}
+	if err := saveSnapshot(targetGroups(targets), opt.Region); err != nil {
+		return err
+	}
+
+	for _, groups := range reap {
+		if err := letmein.CleanGroups(ctx, client, groups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *SnapshotCommand) Execute(args []string) error {
+	ctx := context.Background()
+	targets, _, err := findTargets(ctx, newClient(), args, nil)
+	if err != nil {
+		return err
+	}
+
+	path, err := letmein.WriteSnapshot(snapshotDir(), letmein.NewSnapshot(targetGroups(targets), opt.Region))
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+	return nil
+}
+
+func (c *RestoreCommand) Execute(args []string) error {
+	var path string
+	switch len(args) {
+	case 0:
+		latest, err := letmein.LatestSnapshot(snapshotDir())
+		if err != nil {
+			return err
+		}
+		path = latest
+	case 1:
+		path = args[0]
+	default:
+		return fmt.Errorf("restore takes a single snapshot file")
+	}
+
+	snapshot, err := letmein.ReadSnapshot(path)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "let-me-in: restoring rules saved at %v\n", snapshot.Time.Local().Format(time.RFC1123))
+
+	ids := []string{}
+	for _, saved := range snapshot.Groups {
+		ids = append(ids, saved.GroupId)
+	}
+
+	// restore into the region the snapshot was taken in
+	region := snapshot.Region
+	if region == "" {
+		region = opt.Region
+	}
+
+	ctx := context.Background()
+	client := newRegionClient(region)
+	groups, err := letmein.GetGroups(ctx, client, ids, "group-id")
+	if err != nil {
+		return err
+	}
+	current := map[string]*ec2.SecurityGroup{}
+	for _, group := range groups {
+		current[aws.StringValue(group.GroupId)] = group
+	}
+
+	count := 0
+	for _, saved := range snapshot.Groups {
+		group := current[saved.GroupId]
+		if group == nil {
+			fmt.Fprintf(os.Stderr, "let-me-in: group %v (%v) no longer exists\n", saved.GroupName, saved.GroupId)
+			continue
+		}
+
+		for _, input := range letmein.RestoreDiff(group, saved) {
+			fmt.Printf("+ %v\t%v\t%v\t%v\n", aws.StringValue(group.GroupName), aws.StringValue(input.IpProtocol), input.Cidr(), aws.Int64Value(input.FromPort))
+			count++
+			if c.DryRun {
+				continue
+			}
+			if err := letmein.AuthorizeGroup(ctx, client, group, input); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.DryRun {
+		fmt.Fprintf(os.Stderr, "let-me-in: dry run, %d rules not restored\n", count)
+	} else if count == 0 {
+		fmt.Fprintln(os.Stderr, "let-me-in: nothing to restore")
+	}
+	return nil
+}
+
+func (c *UndoCommand) Execute(args []string) error {
+	entries, err := letmein.ReadLedger(ledgerPath())
+	if err != nil {
+		return err
+	}
+
+	last := letmein.LastRun(entries)
+	if len(last) == 0 {
+		return fmt.Errorf("nothing to undo")
+	}
+	fmt.Fprintf(os.Stderr, "let-me-in: undoing %v run at %v\n", last[0].Mode, last[0].Time.Local().Format(time.RFC1123))
+	ledger.Undoes = last[0].Run
+
+	// reverse changes in the opposite order they were made
+	ctx := context.Background()
+	for i := len(last) - 1; i >= 0; i-- {
+		e := last[i]
+		group := &ec2.SecurityGroup{GroupId: aws.String(e.GroupId)}
+		sign := "+"
+		if e.Action == letmein.ActionAuthorize {
+			sign = "-"
+		}
+		fmt.Printf("%v %v\t%v\t%v\t%v\n", sign, e.GroupId, e.Protocol, e.Cidr, e.FromPort)
+
+		if c.DryRun {
+			continue
+		}
+
+		client := newRegionClient(e.Region)
+		if e.Action == letmein.ActionAuthorize {
+			err = letmein.RevokeGroup(ctx, client, group, e.Input())
+		} else {
+			err = letmein.AuthorizeGroup(ctx, client, group, e.Input())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.DryRun {
+		fmt.Fprintf(os.Stderr, "let-me-in: dry run, %d changes not made\n", len(last))
+	}
+	return nil
+}
+
+func (c *ConfigShowCommand) Execute(args []string) error {
+	showConfig(os.Stdout, cfg)
+	return nil
+}
+
+func (c *IdentServerCommand) Execute(args []string) error {
+	server := &letmein.IdentServer{}
+	for _, cidr := range c.TrustedProxy {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return err
+		}
+		server.TrustedProxies = append(server.TrustedProxies, n)
+	}
+
+	fmt.Printf("serving ident on %v\n", c.Listen)
+	return http.ListenAndServe(c.Listen, server)
+}
+
+func (c *SimulateCommand) Execute(args []string) error {
+	state := &simulator.State{}
+	if c.State != "" {
+		var err error
+		if state, err = simulator.Load(c.State); err != nil {
+			return err
+		}
+	}
+
+	sim, err := simulator.New(state)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("simulating EC2 on %v, use --endpoint http://%v\n", c.Listen, c.Listen)
+	return http.ListenAndServe(c.Listen, sim)
+}
+
+// run old-style invocation with mode flags instead of a subcommand
+func runLegacy(names []string) error {
+	l := opt.Legacy
+
+	// show version and exit
+	if opt.Version {
+		fmt.Printf("let-me-in %v\n", VERSION)
+		return nil
+	}
+
+	modes := 0
+	for _, set := range []bool{l.List, l.Revoke, l.Clean} {
+		if set {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return fmt.Errorf("only one of --list, --revoke or --clean may be given")
+	}
+
+	if len(names) == 0 {
+		parser.WriteHelp(os.Stdout)
+		return nil
+	}
+
+	switch {
+	case l.List:
+		return (&ListCommand{}).Execute(names)
+	case l.Clean:
+		ledger.Mode = "clean"
+		return (&CleanCommand{PolicyOptions: l.PolicyOptions, Yes: l.Yes}).Execute(names)
+	case l.Revoke:
+		ledger.Mode = "revoke"
+		return (&RevokeCommand{PermOptions: l.PermOptions}).Execute(names)
+	case execArgs != nil:
+		ledger.Mode = "exec"
+		return (&ExecCommand{PermOptions: l.PermOptions, For: l.For}).Execute(names)
+	default:
+		ledger.Mode = "grant"
+		return (&GrantCommand{PermOptions: l.PermOptions, For: l.For}).Execute(names)
+	}
+}
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/jessevdk/go-flags"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/vaughan0/go-ini"
+	"github.com/rlister/let-me-in/letmein"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// settings read from config file
+type Config struct {
+	Path     string
+	Defaults map[string]string
+	Recipes  map[string]*Recipe
+	Policy   *letmein.Policy
+}
+
+// named set of groups and permissions, given on cmdline as @name
+type Recipe struct {
+	Name   string
+	Groups []string
+	Filter string
+	Ports  []PortSpec
+	For    time.Duration
+}
+
+// single port and protocol from a recipe
+type PortSpec struct {
+	Port     int64
+	Protocol string
+}
+
+// options that may be given defaults in the [defaults] section of config
+var configurable = []string{"ident", "ident6", "family", "ident-quorum", "ident-timeout", "region", "filter", "port", "protocol"}
+
+// config file location: $LMI_CONFIG, or let-me-in/config under XDG config dir
+func configPath() string {
+	if path := os.Getenv("LMI_CONFIG"); path != "" {
+		return path
+	}
+
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".config")
+	}
+
+	return filepath.Join(dir, "let-me-in", "config")
+}
+
+// directory for snapshots and other state: $LMI_STATE_DIR, or let-me-in
+// under XDG state dir
+func stateDir() string {
+	if dir := os.Getenv("LMI_STATE_DIR"); dir != "" {
+		return dir
+	}
+
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".local", "state")
+	}
+
+	return filepath.Join(dir, "let-me-in")
+}
+
+// read config file; a missing file is the same as an empty one
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{
+		Path:     path,
+		Defaults: map[string]string{},
+		Recipes:  map[string]*Recipe{},
+		Policy:   letmein.NewPolicy(),
+	}
+
+	file, err := ini.LoadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	for name, section := range file {
+		switch {
+		case name == "":
+			continue // keys before first section are ignored
+		case name == "defaults":
+			for key, value := range section {
+				if !isConfigurable(key) {
+					return nil, fmt.Errorf("%v: unknown key in [defaults]: %v", path, key)
+				}
+				cfg.Defaults[key] = value
+			}
+		case name == "policy":
+			if err := parsePolicy(cfg.Policy, section); err != nil {
+				return nil, fmt.Errorf("%v: [policy]: %v", path, err)
+			}
+		case strings.HasPrefix(name, "@"):
+			recipe, err := parseRecipe(name[1:], section)
+			if err != nil {
+				return nil, fmt.Errorf("%v: [%v]: %v", path, name, err)
+			}
+			cfg.Recipes[recipe.Name] = recipe
+		default:
+			return nil, fmt.Errorf("%v: unknown section [%v]", path, name)
+		}
+	}
+
+	return cfg, nil
+}
+
+func isConfigurable(key string) bool {
+	for _, k := range configurable {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// build recipe from its config section
+func parseRecipe(name string, section ini.Section) (*Recipe, error) {
+	recipe := &Recipe{Name: name}
+
+	protocol := "tcp"
+	if p, ok := section["protocol"]; ok {
+		protocol = p
+	}
+
+	for key, value := range section {
+		switch key {
+		case "groups":
+			recipe.Groups = splitList(value)
+		case "filter":
+			recipe.Filter = value
+		case "ports":
+			for _, s := range splitList(value) {
+				port, err := parsePortSpec(s, protocol)
+				if err != nil {
+					return nil, err
+				}
+				recipe.Ports = append(recipe.Ports, port)
+			}
+		case "protocol":
+			// default protocol for ports, handled above
+		case "for":
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration for %v: %v", key, value)
+			}
+			recipe.For = d
+		default:
+			return nil, fmt.Errorf("unknown key: %v", key)
+		}
+	}
+
+	if len(recipe.Groups) == 0 {
+		return nil, fmt.Errorf("no groups given")
+	}
+
+	return recipe, nil
+}
+
+// set limits on cidr blocks from policy section: min-prefix, and stricter
+// port-min-prefix as a list of port:prefix, e.g. 22:32; and the same for
+// IPv6 as min-prefix6 and port-min-prefix6; protected, a list of cidrs
+// whose rules are never removed; and require-reason, a list of groups that
+// need a reason or ticket for access, matching reason-pattern and
+// ticket-pattern; and opt-in, to only change groups tagged for let-me-in
+func parsePolicy(policy *letmein.Policy, section ini.Section) error {
+	for key, value := range section {
+		switch key {
+		case "min-prefix", "min-prefix6":
+			bits := 32
+			if key == "min-prefix6" {
+				bits = 128
+			}
+			prefix, err := parsePrefix(value, bits)
+			if err != nil {
+				return err
+			}
+			if bits == 32 {
+				policy.MinPrefix = prefix
+			} else {
+				policy.MinPrefix6 = prefix
+			}
+		case "port-min-prefix":
+			if err := parsePortPrefixes(value, 32, policy.PortPrefix); err != nil {
+				return err
+			}
+		case "port-min-prefix6":
+			if err := parsePortPrefixes(value, 128, policy.PortPrefix6); err != nil {
+				return err
+			}
+		case "protected":
+			for _, s := range splitList(value) {
+				cidr, _, err := letmein.ParseCidr(s)
+				if err != nil {
+					return err
+				}
+				policy.Protected = append(policy.Protected, cidr)
+			}
+		case "require-reason":
+			policy.RequireReason = splitList(value)
+		case "opt-in":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid opt-in, expected true or false: %v", value)
+			}
+			policy.OptIn = b
+		case "reason-pattern", "ticket-pattern":
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return fmt.Errorf("invalid %v: %v", key, err)
+			}
+			if key == "reason-pattern" {
+				policy.ReasonPattern = re
+			} else {
+				policy.TicketPattern = re
+			}
+		default:
+			return fmt.Errorf("unknown key: %v", key)
+		}
+	}
+	return nil
+}
+
+// parse list of port:prefix into limits
+func parsePortPrefixes(value string, bits int, limits map[int64]int) error {
+	for _, s := range splitList(value) {
+		i := strings.Index(s, ":")
+		if i < 0 {
+			return fmt.Errorf("invalid port limit, expected port:prefix: %v", s)
+		}
+		port, err := strconv.ParseInt(s[:i], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid port: %v", s[:i])
+		}
+		prefix, err := parsePrefix(s[i+1:], bits)
+		if err != nil {
+			return err
+		}
+		limits[port] = prefix
+	}
+	return nil
+}
+
+// parse prefix length up to bits, with or without leading slash
+func parsePrefix(s string, bits int) (int, error) {
+	prefix, err := strconv.Atoi(strings.TrimPrefix(s, "/"))
+	if err != nil || prefix < 0 || prefix > bits {
+		return 0, fmt.Errorf("invalid prefix length: %v", s)
+	}
+	return prefix, nil
+}
+
+// parse port as 5432 or 53/udp, using given default protocol
+func parsePortSpec(s string, protocol string) (PortSpec, error) {
+	if i := strings.Index(s, "/"); i >= 0 {
+		s, protocol = s[:i], s[i+1:]
+	}
+
+	port, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return PortSpec{}, fmt.Errorf("invalid port: %v", s)
+	}
+
+	return PortSpec{Port: port, Protocol: protocol}, nil
+}
+
+// split comma or whitespace separated list
+func splitList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+// set config values as option defaults, so cmdline and env vars take precedence
+func applyConfigDefaults(parser *flags.Parser, cfg *Config) {
+	setDefaults(parser.Command, cfg.Defaults)
+}
+
+// set defaults for options of command and all its subcommands
+func setDefaults(cmd *flags.Command, defaults map[string]string) {
+	for key, value := range defaults {
+		if option := cmd.FindOptionByLongName(key); option != nil {
+			if reflect.ValueOf(option.Value()).Kind() == reflect.Slice {
+				option.Default = splitList(value)
+			} else {
+				option.Default = []string{value}
+			}
+		}
+	}
+
+	for _, c := range cmd.Commands() {
+		setDefaults(c, defaults)
+	}
+}
+
+// print merged configuration: defaults after cmdline, env and config are applied, and all recipes
+func showConfig(out io.Writer, cfg *Config) {
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+
+	fmt.Fprintf(w, "# %v\n", cfg.Path)
+	fmt.Fprintf(w, "[defaults]\n")
+	fmt.Fprintf(w, "ident\t= %v\n", strings.Join(opt.Ident, ", "))
+	fmt.Fprintf(w, "ident6\t= %v\n", strings.Join(opt.Ident6, ", "))
+	fmt.Fprintf(w, "family\t= %v\n", opt.Legacy.Family)
+	fmt.Fprintf(w, "ident-quorum\t= %v\n", opt.IdentQuorum)
+	fmt.Fprintf(w, "ident-timeout\t= %v\n", opt.IdentTimeout)
+	fmt.Fprintf(w, "region\t= %v\n", opt.Region)
+	fmt.Fprintf(w, "filter\t= %v\n", opt.Filter)
+	fmt.Fprintf(w, "port\t= %v\n", opt.Legacy.Port) // top-level options have had all defaults applied
+	fmt.Fprintf(w, "protocol\t= %v\n", opt.Legacy.Protocol)
+
+	fmt.Fprintf(w, "\n[policy]\n")
+	fmt.Fprintf(w, "min-prefix\t= %v\n", cfg.Policy.MinPrefix)
+	if len(cfg.Policy.PortPrefix) > 0 {
+		fmt.Fprintf(w, "port-min-prefix\t= %v\n", portPrefixes(cfg.Policy.PortPrefix))
+	}
+	fmt.Fprintf(w, "min-prefix6\t= %v\n", cfg.Policy.MinPrefix6)
+	if len(cfg.Policy.PortPrefix6) > 0 {
+		fmt.Fprintf(w, "port-min-prefix6\t= %v\n", portPrefixes(cfg.Policy.PortPrefix6))
+	}
+	if len(cfg.Policy.Protected) > 0 {
+		fmt.Fprintf(w, "protected\t= %v\n", strings.Join(cfg.Policy.Protected, ", "))
+	}
+	if len(cfg.Policy.RequireReason) > 0 {
+		fmt.Fprintf(w, "require-reason\t= %v\n", strings.Join(cfg.Policy.RequireReason, ", "))
+	}
+	if cfg.Policy.OptIn {
+		fmt.Fprintf(w, "opt-in\t= true\n")
+	}
+	if cfg.Policy.ReasonPattern != nil {
+		fmt.Fprintf(w, "reason-pattern\t= %v\n", cfg.Policy.ReasonPattern)
+	}
+	if cfg.Policy.TicketPattern != nil {
+		fmt.Fprintf(w, "ticket-pattern\t= %v\n", cfg.Policy.TicketPattern)
+	}
+
+	names := make([]string, 0, len(cfg.Recipes))
+	for name := range cfg.Recipes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		recipe := cfg.Recipes[name]
+
+		ports := make([]string, len(recipe.Ports))
+		for i, p := range recipe.Ports {
+			ports[i] = fmt.Sprintf("%v/%v", p.Port, p.Protocol)
+		}
+
+		fmt.Fprintf(w, "\n[@%v]\n", name)
+		fmt.Fprintf(w, "groups\t= %v\n", strings.Join(recipe.Groups, ", "))
+		if recipe.Filter != "" {
+			fmt.Fprintf(w, "filter\t= %v\n", recipe.Filter)
+		}
+		if len(ports) > 0 {
+			fmt.Fprintf(w, "ports\t= %v\n", strings.Join(ports, ", "))
+		}
+		if recipe.For > 0 {
+			fmt.Fprintf(w, "for\t= %v\n", recipe.For)
+		}
+	}
+
+	w.Flush()
+}
+
+// format port limits sorted by port, e.g. 22:32, 3389:32
+func portPrefixes(limits map[int64]int) string {
+	ports := make([]int64, 0, len(limits))
+	for port := range limits {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	list := make([]string, len(ports))
+	for i, port := range ports {
+		list[i] = fmt.Sprintf("%v:%v", port, limits[port])
+	}
+	return strings.Join(list, ", ")
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "let-me-in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := writeConfig(t, `
+[defaults]
+filter = tag:Name
+region = us-west-2
+
+[policy]
+min-prefix = /28
+port-min-prefix = 22:32, 3389:32
+min-prefix6 = 56
+port-min-prefix6 = 22:128
+protected = 10.0.0.0/8, 198.51.100.7
+require-reason = db-sg, sg-0123
+ticket-pattern = ^OPS-[0-9]+$
+opt-in = true
+
+[@prod-db]
+groups = db-sg, db-replica-sg
+ports = 5432 53/udp
+for = 1h
+`)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Defaults["filter"] != "tag:Name" || cfg.Defaults["region"] != "us-west-2" {
+		t.Errorf("wrong defaults: %v", cfg.Defaults)
+	}
+
+	if cfg.Policy.MinPrefix != 28 || cfg.Policy.PortPrefix[22] != 32 || cfg.Policy.PortPrefix[3389] != 32 ||
+		cfg.Policy.MinPrefix6 != 56 || cfg.Policy.PortPrefix6[22] != 128 ||
+		len(cfg.Policy.Protected) != 2 || cfg.Policy.Protected[1] != "198.51.100.7/32" ||
+		len(cfg.Policy.RequireReason) != 2 || !cfg.Policy.TicketPattern.MatchString("OPS-1") || cfg.Policy.ReasonPattern != nil ||
+		!cfg.Policy.OptIn {
+		t.Errorf("wrong policy: %v", cfg.Policy)
+	}
+
+	recipe := cfg.Recipes["prod-db"]
+	if recipe == nil {
+		t.Fatal("recipe not found")
+	}
+	if len(recipe.Groups) != 2 || recipe.Groups[1] != "db-replica-sg" {
+		t.Errorf("wrong groups: %v", recipe.Groups)
+	}
+	if len(recipe.Ports) != 2 || recipe.Ports[0] != (PortSpec{5432, "tcp"}) || recipe.Ports[1] != (PortSpec{53, "udp"}) {
+		t.Errorf("wrong ports: %v", recipe.Ports)
+	}
+	if recipe.For != time.Hour {
+		t.Errorf("wrong duration: %v", recipe.For)
+	}
+}
+
+func TestLoadConfigMissing(t *testing.T) {
+	cfg, err := loadConfig("/nonexistent/let-me-in/config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Recipes) != 0 {
+		t.Errorf("expected empty config, got %v", cfg)
+	}
+	if cfg.Policy.MinPrefix != 24 {
+		t.Errorf("expected default policy, got %v", cfg.Policy)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	for _, content := range []string{
+		"[defaults]\nbogus = 1\n",
+		"[unknown]\n",
+		"[@x]\nports = 22\n",
+		"[@x]\ngroups = sg\nports = ssh\n",
+		"[@x]\ngroups = sg\nfor = forever\n",
+		"[policy]\nmin-prefix = 33\n",
+		"[policy]\nport-min-prefix = 22\n",
+		"[policy]\nport-min-prefix = 22:64\n",
+		"[policy]\nmin-prefix6 = 129\n",
+		"[policy]\nbogus = 1\n",
+		"[policy]\nprotected = office\n",
+		"[policy]\nopt-in = maybe\n",
+		"[policy]\nreason-pattern = [a-\n",
+	} {
+		path := writeConfig(t, content)
+		if _, err := loadConfig(path); err == nil {
+			t.Errorf("expected error for config %q", content)
+		}
+		os.RemoveAll(filepath.Dir(path))
+	}
+}
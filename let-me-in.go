@@ -3,151 +3,44 @@ package main
 import (
 	"fmt"
 	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
-	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/jessevdk/go-flags"
-	"io/ioutil"
-	"net/http"
+	"github.com/rlister/let-me-in/letmein"
+	"io"
 	"os"
-	"os/exec"
+	"strings"
 	"text/tabwriter"
+	"time"
 )
 
 var VERSION = "dev"
 
+// global options, and legacy mode flags from before subcommands were added
 var opt struct {
-	Version  bool   `short:"v" long:"version" description:"show version and exit"`
-	List     bool   `short:"l" long:"list" description:"list current rules for security groups"`
-	Cidr     string `short:"c" long:"cidr" description:"set a specific cidr block (default: current public ip)"`
-	Port     int    `short:"p" long:"port" default:"22" description:"port number to allow"`
-	Protocol string `short:"P" long:"protocol" default:"tcp" description:"protocol to allow: tcp, udp or icmp"`
-	Revoke   bool   `short:"r" long:"revoke" description:"revoke access from security groups"`
-	Clean    bool   `short:"x" long:"clean" description:"clean listed groups, i.e. revoke all access"`
-	Filter   string `short:"f" long:"filter" default:"group-name" description:"filter to use for groups"`
-	Ident    string `long:"ident" default:"http://v4.ident.me/" env:"LMI_IDENT_URL" description:"URL for ident service"`
+	Version      bool          `short:"v" long:"version" description:"show version and exit"`
+	Filter       string        `short:"f" long:"filter" default:"group-name" description:"filter to use for groups"`
+	Ident        []string      `long:"ident" default:"https://v4.ident.me/" default:"https://ipv4.icanhazip.com/" default:"https://checkip.amazonaws.com/" env:"LMI_IDENT_URL" env-delim:"," description:"URL for ident service, may be repeated"`
+	Ident6       []string      `long:"ident6" default:"https://v6.ident.me/" default:"https://ipv6.icanhazip.com/" env:"LMI_IDENT6_URL" env-delim:"," description:"URL for IPv6 ident service, may be repeated"`
+	IdentQuorum  int           `long:"ident-quorum" description:"number of ident services that must agree (default: a majority)"`
+	IdentTimeout time.Duration `long:"ident-timeout" default:"5s" description:"timeout for each ident service"`
+	Region       string        `long:"region" env:"AWS_REGION" description:"AWS region"`
+	Endpoint     string        `long:"endpoint" env:"LMI_ENDPOINT" description:"EC2 endpoint URL, e.g. to use simulator"`
+	Audit        string        `long:"audit" env:"LMI_AUDIT" description:"log a JSON event for every change to stdout, syslog or a file"`
+	NoMetadata   bool          `long:"no-metadata" description:"do not use EC2 instance metadata to find public ip"`
+	Metadata     string        `long:"metadata-endpoint" env:"LMI_METADATA_URL" hidden:"yes" description:"EC2 instance metadata URL"`
+
+	Legacy LegacyOptions `group:"Legacy Options" hidden:"yes"`
 }
 
-type Input struct {
-	GroupId    *string
-	IpProtocol *string
-	FromPort   *int64
-	ToPort     *int64
-	CidrIp     *string
-}
-
-// error handler
-func check(e error) {
-	if e != nil {
-		panic(e.Error())
-	}
-}
-
-// return array of security group objects for given groups, by filter (group-name, group-id, tag:Name, etc)
-func getGroups(client *ec2.EC2, names []string, filter string) ([]*ec2.SecurityGroup, error) {
-
-	// get names as array of aws.String objects
-	values := make([]*string, len(names))
-	for i, name := range names {
-		values[i] = aws.String(name)
-	}
-
-	// request params as filter for names
-	params := &ec2.DescribeSecurityGroupsInput{
-		Filters: []*ec2.Filter{
-			{
-				Name:   aws.String(filter),
-				Values: values,
-			},
-		},
-	}
-
-	// send request
-	resp, err := client.DescribeSecurityGroups(params)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp.SecurityGroups, nil
-}
-
-func authorizeGroups(client *ec2.EC2, groups []*ec2.SecurityGroup, input Input) {
-	for _, group := range groups {
-		authorizeGroup(client, group, input)
-	}
-}
+// command to exec, given on cmdline after '--'
+var execArgs []string
 
-// add given permission to security group
-func authorizeGroup(client *ec2.EC2, group *ec2.SecurityGroup, input Input) {
-	_, err := client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
-		GroupId:    group.GroupId,
-		IpProtocol: input.IpProtocol,
-		FromPort:   input.FromPort,
-		ToPort:     input.ToPort,
-		CidrIp:     input.CidrIp,
-	})
-
-	// be idempotent, i.e. skip error if this permission already exists in group
-	if err != nil {
-		if err.(awserr.Error).Code() != "InvalidPermission.Duplicate" {
-			panic(err)
-		}
-	}
-}
-
-func revokeGroups(client *ec2.EC2, groups []*ec2.SecurityGroup, input Input) {
-	for _, group := range groups {
-		revokeGroup(client, group, input)
-	}
-}
-
-// revoke given permission for security group
-func revokeGroup(client *ec2.EC2, group *ec2.SecurityGroup, input Input) {
-	_, err := client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
-		GroupId:    group.GroupId,
-		IpProtocol: input.IpProtocol,
-		FromPort:   input.FromPort,
-		ToPort:     input.ToPort,
-		CidrIp:     input.CidrIp,
-	})
-
-	// be idempotent, i.e. skip error if this permission already exists in group
-	if err != nil {
-		if err.(awserr.Error).Code() != "InvalidPermission.NotFound" {
-			panic(err)
-		}
-	}
-}
-
-func cleanGroups(client *ec2.EC2, groups []*ec2.SecurityGroup) {
-	for _, group := range groups {
-		cleanGroup(client, group)
-	}
-}
-
-// revoke all existing permissions for security group
-func cleanGroup(client *ec2.EC2, group *ec2.SecurityGroup) {
-	for _, perm := range group.IpPermissions {
-		for _, cidr := range perm.IpRanges {
-			revokeGroup(client, group, Input{
-				IpProtocol: perm.IpProtocol,
-				FromPort:   perm.FromPort,
-				ToPort:     perm.ToPort,
-				CidrIp:     cidr.CidrIp,
-			})
-		}
-	}
-}
-
-// get my external-facing IP as a string
-func getMyIp(ident string) string {
-	resp, err := http.Get(ident)
-	check(err)
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	check(err)
-
-	return string(body)
+// groups to look up with a filter, and the permissions to apply to them
+type Target struct {
+	Filter string
+	Names  []string
+	Inputs []letmein.Input
+	Groups []*ec2.SecurityGroup
 }
 
 // parse cmdline argv into args before '--' and cmd to exec afterwards
@@ -164,88 +57,134 @@ func parseArgs(args []string) ([]string, []string) {
 	return args, nil
 }
 
-// print out table of current IP permissions for given security groups
+// print out table of current IP permissions for given security groups,
+// with owner and reason of rules granted by let-me-in
 func printIpRanges(groups []*ec2.SecurityGroup) {
+	writeIpRanges(os.Stdout, groups)
+}
+
+// write table of IP permissions to out; all-traffic rules have no ports
+func writeIpRanges(out io.Writer, groups []*ec2.SecurityGroup) {
 	w := new(tabwriter.Writer)
-	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+	w.Init(out, 0, 8, 1, '\t', 0)
 	for _, group := range groups {
 		for _, perm := range group.IpPermissions {
-			for _, cidr := range perm.IpRanges {
-				fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", *group.GroupName, *perm.IpProtocol, *cidr.CidrIp, *perm.FromPort)
+			protocol := aws.StringValue(perm.IpProtocol)
+			port := fmt.Sprint(aws.Int64Value(perm.FromPort))
+			if protocol == "-1" {
+				port = "all"
+			}
+			for _, cidr := range letmein.Ranges(perm) {
+				owner, reason := ruleOwner(letmein.RangeDescription(perm, cidr))
+				fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n", aws.StringValue(group.GroupName), protocol, cidr, port, owner, reason)
 			}
 		}
 	}
 	w.Flush()
 }
 
-func main() {
-	flags.Parse(&opt)
-
-	// show version and exit
-	if opt.Version {
-		fmt.Printf("let-me-in %v\n", VERSION)
-		return
+// owner and reason recorded in rule description by let-me-in; for other
+// rules the description is the reason
+func ruleOwner(description string) (string, string) {
+	grant, ok := letmein.ParseGrant(description)
+	if !ok {
+		return "-", description
 	}
 
-	// if cidr not given get ip from external service
-	if opt.Cidr == "" {
-		opt.Cidr = getMyIp(opt.Ident) + "/32"
+	owner := grant.Owner()
+	if owner == "" {
+		owner = "let-me-in"
 	}
+	reason := strings.TrimSpace(grant.Ticket + " " + grant.Reason)
+	return owner, reason
+}
 
-	// requested permissions
-	input := Input{
-		IpProtocol: &opt.Protocol,
-		FromPort:   aws.Int64(int64(opt.Port)),
-		ToPort:     aws.Int64(int64(opt.Port)),
-		CidrIp:     &opt.Cidr,
-	}
+// build targets from group names on cmdline, expanding any @recipe names;
+// returns shortest duration limit set by recipes, or 0 if none
+func expandTargets(cfg *Config, names []string, filter string, inputs []letmein.Input, portIsSet bool) ([]*Target, time.Duration, error) {
+	plain := &Target{Filter: filter, Inputs: inputs}
+	targets := []*Target{}
+	var limit time.Duration
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, "@") {
+			plain.Names = append(plain.Names, name)
+			continue
+		}
 
-	// get security group names and any command to exec after '--'
-	groupNames, cmd := parseArgs(os.Args[1:])
+		recipe, ok := cfg.Recipes[name[1:]]
+		if !ok {
+			return nil, 0, fmt.Errorf("no such recipe: %v", name)
+		}
 
-	// configure aws-sdk from AWS_* env vars
-	client := ec2.New(&aws.Config{})
+		target := &Target{Filter: filter, Names: recipe.Groups, Inputs: inputs}
+		if recipe.Filter != "" {
+			target.Filter = recipe.Filter
+		}
 
-	// get details for listed groups
-	groups, err := getGroups(client, groupNames, opt.Filter)
-	if err != nil {
-		fmt.Printf("%v\n", err) // if AWS creds not configured, report it here
-		return
-	}
+		// ports from recipe for each cidr, unless overridden on cmdline
+		if len(recipe.Ports) > 0 && !portIsSet {
+			target.Inputs = []letmein.Input{}
+			for _, input := range inputs {
+				for _, p := range recipe.Ports {
+					recipeInput := letmein.NewInput(p.Protocol, p.Port, input.Cidr())
+					recipeInput.Description = input.Description
+					target.Inputs = append(target.Inputs, recipeInput)
+				}
+			}
+		}
+
+		if recipe.For > 0 && (limit == 0 || recipe.For < limit) {
+			limit = recipe.For
+		}
 
-	// print list of current IP permissions for groups
-	if opt.List {
-		printIpRanges(groups)
-		return
+		targets = append(targets, target)
 	}
 
-	// remove all existing permissions for groups
-	if opt.Clean {
-		cleanGroups(client, groups)
-		return
+	if len(plain.Names) > 0 {
+		targets = append(targets, plain)
 	}
 
-	// revoke given permission for groups
-	if opt.Revoke {
-		revokeGroups(client, groups, input)
-		return
+	return targets, limit, nil
+}
+
+func main() {
+	parser = flags.NewParser(&opt, flags.Default)
+	parser.SubcommandsOptional = true
+	addCommands(parser)
+
+	// load config file and use it to set option defaults
+	var err error
+	cfg, err = loadConfig(configPath())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
+	applyConfigDefaults(parser, cfg)
 
-	// default behaviour
-	authorizeGroups(client, groups, input)
+	ledger = letmein.NewLedger(ledgerPath())
+	ledger.Warn = func(err error) {
+		fmt.Fprintf(os.Stderr, "let-me-in: warning: change not recorded in ledger: %v\n", err)
+	}
 
-	// exec any command after '--', then revoke
-	if cmd != nil {
-		c := exec.Command(cmd[0], cmd[1:]...)
-		c.Stdout = os.Stdout
-		c.Stdin = os.Stdin
-		c.Stderr = os.Stderr
+	// split cmdline into our args and any command to exec after '--'
+	args, cmd := parseArgs(os.Args[1:])
+	execArgs = cmd
 
-		err := c.Run()
-		if err != nil {
-			fmt.Println(err) // show err and keep running so we hit revoke below
+	// parse args and run any subcommand
+	groupNames, err := parser.ParseArgs(args)
+	if err != nil {
+		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+			return
 		}
+		os.Exit(1)
+	}
 
-		revokeGroups(client, groups, input)
+	// no subcommand given, use legacy mode
+	if parser.Active == nil {
+		if err := runLegacy(groupNames); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 	}
 }
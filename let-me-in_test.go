@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/letmein"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		in   []string
+		args []string
+		cmd  []string
+	}{
+		{[]string{}, []string{}, nil},
+		{[]string{"sg"}, []string{"sg"}, nil},
+		{[]string{"-p", "80", "sg"}, []string{"-p", "80", "sg"}, nil},
+		{[]string{"sg", "--", "ssh", "host"}, []string{"sg"}, []string{"ssh", "host"}},
+		{[]string{"--", "ssh"}, []string{}, []string{"ssh"}},
+		{[]string{"sg", "--"}, []string{"sg"}, []string{}},
+		{[]string{"sg", "--", "ssh", "--", "x"}, []string{"sg"}, []string{"ssh", "--", "x"}},
+	}
+
+	for _, test := range tests {
+		args, cmd := parseArgs(test.in)
+		if !reflect.DeepEqual(args, test.args) || !reflect.DeepEqual(cmd, test.cmd) {
+			t.Errorf("parseArgs(%q) = %q, %q; expected %q, %q", test.in, args, cmd, test.args, test.cmd)
+		}
+	}
+}
+
+func TestSaveSnapshotRegion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "let-me-in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv("LMI_STATE_DIR", dir)
+	defer os.Unsetenv("LMI_STATE_DIR")
+
+	// groups found scanning another region are saved as from that region
+	defer func(region string) { opt.Region = region }(opt.Region)
+	opt.Region = "us-east-1"
+	groups := []*ec2.SecurityGroup{{GroupId: aws.String("sg-1"), GroupName: aws.String("web")}}
+	if err := saveSnapshot(groups, "eu-west-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := letmein.LatestSnapshot(snapshotDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := letmein.ReadSnapshot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Region != "eu-west-1" {
+		t.Errorf("expected snapshot of eu-west-1, got %q", snapshot.Region)
+	}
+}
+
+func TestWriteIpRanges(t *testing.T) {
+	groups := []*ec2.SecurityGroup{{
+		GroupName: aws.String("web"),
+		IpPermissions: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"), FromPort: aws.Int64(22), ToPort: aws.Int64(22),
+				IpRanges: []*ec2.IpRange{{CidrIp: aws.String("198.51.100.1/32")}},
+			},
+			{
+				// all traffic rules come without ports
+				IpProtocol: aws.String("-1"),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+			},
+		},
+	}}
+
+	buf := &bytes.Buffer{}
+	writeIpRanges(buf, groups)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || strings.Join(strings.Fields(lines[0]), " ") != "web tcp 198.51.100.1/32 22 -" ||
+		strings.Join(strings.Fields(lines[1]), " ") != "web -1 10.0.0.0/8 all -" {
+		t.Errorf("unexpected table:\n%v", buf.String())
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	web := &ec2.SecurityGroup{GroupName: aws.String("web")}
+	mosh := letmein.NewInput("udp", 60000, "203.0.113.0/24")
+	mosh.ToPort = aws.Int64(61000)
+	all := letmein.NewInput("-1", -1, "192.0.2.0/24")
+
+	for _, test := range []struct {
+		ch   change
+		plan string
+	}{
+		{change{web, true, letmein.NewInput("tcp", 22, "203.0.113.1/32")}, "+ web tcp 203.0.113.1/32 22"},
+		{change{web, true, mosh}, "+ web udp 203.0.113.0/24 60000-61000"},
+		{change{web, false, all}, "- web -1 192.0.2.0/24 all"},
+	} {
+		if plan := strings.Join(strings.Fields(test.ch.String()), " "); plan != test.plan {
+			t.Errorf("expected %q, got %q", test.plan, plan)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package letmein
+
+import (
+	"encoding/json"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// kinds of audit event; authorize and revoke are single rules, clean and
+// exec are sessions that may change many
+const (
+	EventAuthorize = "authorize"
+	EventRevoke    = "revoke"
+	EventClean     = "clean"
+	EventExec      = "exec"
+)
+
+// results of audit events
+const (
+	ResultSuccess   = "success"
+	ResultUnchanged = "unchanged" // rule already existed, or was already gone
+	ResultFailure   = "failure"
+)
+
+// AuditEvent is a change made by let-me-in, written as a single line of
+// JSON for log collectors. Rule fields are empty for sessions.
+type AuditEvent struct {
+	Time     time.Time `json:"time"`
+	Event    string    `json:"event"`
+	Actor    string    `json:"actor"`
+	Host     string    `json:"host,omitempty"`
+	Run      string    `json:"run,omitempty"`     // as in the ledger
+	Command  string    `json:"command,omitempty"` // let-me-in command, e.g. grant
+	Region   string    `json:"region,omitempty"`
+	GroupId  string    `json:"group_id,omitempty"`
+	Groups   []string  `json:"groups,omitempty"` // changed by a session
+	Protocol string    `json:"protocol,omitempty"`
+	FromPort *int64    `json:"from_port,omitempty"`
+	ToPort   *int64    `json:"to_port,omitempty"`
+	Cidr     string    `json:"cidr,omitempty"`
+	Ticket   string    `json:"ticket,omitempty"` // from the rule description, as recorded by grant
+	Reason   string    `json:"reason,omitempty"` //
+	Rules    int       `json:"rules,omitempty"`  // changed by a session
+	Exec     []string  `json:"exec,omitempty"`   // command run by an exec session
+	Result   string    `json:"result"`
+	Error    string    `json:"error,omitempty"`
+	Duration float64   `json:"duration_seconds"`
+}
+
+// Auditor writes audit events as JSON lines to a sink.
+type Auditor struct {
+	W       io.Writer
+	Actor   string
+	Host    string
+	Run     string
+	Command string
+	Ticket  string      // for events whose rule description records none
+	Reason  string      //
+	Warn    func(error) // called if an event from a client cannot be written, if set
+
+	mu sync.Mutex
+}
+
+// NewAuditor returns an auditor writing events by actor on host to w.
+func NewAuditor(w io.Writer, actor, host string) *Auditor {
+	return &Auditor{W: w, Actor: actor, Host: host}
+}
+
+// OpenAuditSink returns the writer for sink, which is stdout, syslog, or
+// the path of a file to append to.
+func OpenAuditSink(sink string) (io.Writer, error) {
+	switch sink {
+	case "stdout", "-":
+		return os.Stdout, nil
+	case "syslog":
+		return openSyslog()
+	}
+	return os.OpenFile(strings.TrimPrefix(sink, "file:"), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+}
+
+// Log writes event, filling in time, actor, host, run, command, ticket and
+// reason if not set. Each event is a single write, so it is not interleaved with others.
+func (a *Auditor) Log(event AuditEvent) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	if event.Actor == "" {
+		event.Actor, event.Host = a.Actor, a.Host
+	}
+	if event.Run == "" {
+		event.Run = a.Run
+	}
+	if event.Command == "" {
+		event.Command = a.Command
+	}
+	if event.Ticket == "" && event.Reason == "" {
+		event.Ticket, event.Reason = a.Ticket, a.Reason
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err = a.W.Write(append(data, '\n'))
+	return err
+}
+
+// Session logs the end of a clean or exec session started at start, as a
+// success unless err is set.
+func (a *Auditor) Session(event AuditEvent, start time.Time, err error) error {
+	event.Result = ResultSuccess
+	if err != nil {
+		event.Result, event.Error = ResultFailure, err.Error()
+	}
+	event.Duration = time.Since(start).Seconds()
+	return a.Log(event)
+}
+
+// NewAuditClient wraps client so that an event is logged for every ingress
+// rule it tries to authorize or revoke in region, whether or not it
+// succeeds.
+func NewAuditClient(client ec2iface.EC2API, auditor *Auditor, region string) ec2iface.EC2API {
+	return &auditClient{client, auditor, region}
+}
+
+type auditClient struct {
+	ec2iface.EC2API
+	auditor *Auditor
+	region  string
+}
+
+func (c *auditClient) AuthorizeSecurityGroupIngress(in *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	start := time.Now()
+	out, err := c.EC2API.AuthorizeSecurityGroupIngress(in)
+	c.log(EventAuthorize, in.GroupId, in.IpPermissions, start, err, "InvalidPermission.Duplicate")
+	return out, err
+}
+
+func (c *auditClient) RevokeSecurityGroupIngress(in *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	start := time.Now()
+	out, err := c.EC2API.RevokeSecurityGroupIngress(in)
+	c.log(EventRevoke, in.GroupId, in.IpPermissions, start, err, "InvalidPermission.NotFound")
+	return out, err
+}
+
+// log each range of perms; errors with code unchanged are not failures
+func (c *auditClient) log(event string, groupId *string, perms []*ec2.IpPermission, start time.Time, err error, unchanged string) {
+	result, msg := ResultSuccess, ""
+	switch {
+	case isCode(err, unchanged):
+		result = ResultUnchanged
+	case err != nil:
+		result, msg = ResultFailure, err.Error()
+	}
+	d := time.Since(start).Seconds()
+
+	for _, perm := range perms {
+		for _, cidr := range Ranges(perm) {
+			grant, _ := ParseGrant(RangeDescription(perm, cidr))
+			e := AuditEvent{
+				Event:    event,
+				Region:   c.region,
+				GroupId:  aws.StringValue(groupId),
+				Protocol: aws.StringValue(perm.IpProtocol),
+				FromPort: perm.FromPort,
+				ToPort:   perm.ToPort,
+				Cidr:     cidr,
+				Ticket:   grant.Ticket,
+				Reason:   grant.Reason,
+				Result:   result,
+				Error:    msg,
+				Duration: d,
+			}
+			if err := c.auditor.Log(e); err != nil && c.auditor.Warn != nil {
+				c.auditor.Warn(err)
+			}
+		}
+	}
+}
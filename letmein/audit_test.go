@@ -0,0 +1,111 @@
+package letmein
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"strings"
+	"testing"
+	"time"
+)
+
+// events written to buf, one per line
+func readEvents(t *testing.T, buf *bytes.Buffer) []AuditEvent {
+	events := []AuditEvent{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var e AuditEvent
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("invalid event %q: %v", line, err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestAuditClient(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("core", "web")
+	buf := &bytes.Buffer{}
+	auditor := NewAuditor(buf, "alice", "laptop")
+	auditor.Run, auditor.Command = "run-1", "grant"
+
+	policy := NewPolicy()
+	policy.OptIn = true
+	client := NewAuditClient(NewGuardClient(f, policy), auditor, "us-east-1")
+	f.groups[1].Tags = append(f.groups[1].Tags, tag(OptInTag, OptInEnabled))
+
+	web := f.groups[1]
+	AuthorizeGroup(ctx, client, web, sshInput("198.51.100.1/32"))
+	AuthorizeGroup(ctx, client, web, sshInput("198.51.100.1/32"))
+	RevokeGroup(ctx, client, web, sshInput("198.51.100.1/32"))
+	AuthorizeGroup(ctx, client, f.groups[0], sshInput("198.51.100.1/32"))
+
+	events := readEvents(t, buf)
+	if len(events) != 4 {
+		t.Fatalf("expected 4 events, got %v", events)
+	}
+
+	e := events[0]
+	if e.Event != EventAuthorize || e.Actor != "alice" || e.Host != "laptop" || e.Run != "run-1" || e.Command != "grant" ||
+		e.Region != "us-east-1" || e.GroupId != "sg-1" || e.Protocol != "tcp" || *e.FromPort != 22 || *e.ToPort != 22 ||
+		e.Cidr != "198.51.100.1/32" || e.Result != ResultSuccess || e.Time.IsZero() {
+		t.Errorf("unexpected authorize event: %+v", e)
+	}
+	if events[1].Result != ResultUnchanged || events[2].Event != EventRevoke || events[2].Result != ResultSuccess {
+		t.Errorf("unexpected events: %+v %+v", events[1], events[2])
+	}
+	if e := events[3]; e.Result != ResultFailure || !strings.Contains(e.Error, "not tagged") {
+		t.Errorf("expected refused authorize to fail, got %+v", e)
+	}
+	if e := events[0]; e.Ticket != "" || e.Reason != "" {
+		t.Errorf("expected no ticket or reason without a grant description, got %+v", e)
+	}
+}
+
+func TestAuditClientReason(t *testing.T) {
+	f := newFakeEC2("web")
+	buf := &bytes.Buffer{}
+	auditor := NewAuditor(buf, "alice", "")
+	auditor.Ticket = "OPS-2"
+	client := NewAuditClient(f, auditor, "us-east-1")
+
+	input := sshInput("198.51.100.1/32")
+	input.Description = aws.String(Grant{User: "alice", Ticket: "OPS-1", Reason: "db migration"}.Description())
+	AuthorizeGroup(context.Background(), client, f.groups[0], input)
+
+	// rules without a grant description, e.g. from apply, get those of the auditor
+	input = sshInput("198.51.100.2/32")
+	input.Description = aws.String("mosh")
+	AuthorizeGroup(context.Background(), client, f.groups[0], input)
+
+	events := readEvents(t, buf)
+	if e := events[0]; e.Ticket != "OPS-1" || e.Reason != "db migration" {
+		t.Errorf("expected ticket and reason recorded, got %+v", e)
+	}
+	if e := events[1]; e.Ticket != "OPS-2" || e.Reason != "" {
+		t.Errorf("expected ticket of auditor, got %+v", e)
+	}
+}
+
+func TestAuditorSession(t *testing.T) {
+	buf := &bytes.Buffer{}
+	auditor := NewAuditor(buf, "alice", "")
+
+	start := time.Now().Add(-2 * time.Second)
+	auditor.Session(AuditEvent{Event: EventExec, Groups: []string{"sg-1"}, Rules: 1, Exec: []string{"ssh", "db"}}, start, nil)
+	auditor.Session(AuditEvent{Event: EventClean, Groups: []string{"sg-1"}, Rules: 3}, start, errors.New("throttled"))
+
+	events := readEvents(t, buf)
+	if e := events[0]; e.Result != ResultSuccess || e.Duration < 2 || len(e.Exec) != 2 || e.FromPort != nil {
+		t.Errorf("unexpected exec session: %+v", e)
+	}
+	if e := events[1]; e.Result != ResultFailure || e.Error != "throttled" || e.Rules != 3 {
+		t.Errorf("unexpected clean session: %+v", e)
+	}
+
+	if strings.Contains(buf.String(), "from_port") || strings.Contains(buf.String(), "host") {
+		t.Errorf("expected empty rule fields omitted: %v", buf.String())
+	}
+}
@@ -0,0 +1,108 @@
+package letmein
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Family selects IPv4, IPv6 or both kinds of address.
+type Family int
+
+const (
+	IPv4 Family = 1 << iota
+	IPv6
+	DualStack = IPv4 | IPv6
+)
+
+// ParseFamily parses a family given as 4, 6 or both.
+func ParseFamily(s string) (Family, error) {
+	switch s {
+	case "4":
+		return IPv4, nil
+	case "6":
+		return IPv6, nil
+	case "both":
+		return DualStack, nil
+	}
+	return 0, fmt.Errorf("invalid address family %q, expected 4, 6 or both", s)
+}
+
+// Has returns true if f includes address family of ip.
+func (f Family) Has(ip net.IP) bool {
+	if ip.To4() != nil {
+		return f&IPv4 != 0
+	}
+	return f&IPv6 != 0
+}
+
+// ParseCidr validates an IPv4 or IPv6 cidr block given by a user. A bare
+// address becomes a /32 or /128. If the block has host bits set they are
+// cleared, and normalized is true so the caller can warn about it.
+func ParseCidr(s string) (cidr string, normalized bool, err error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return "", false, fmt.Errorf("invalid cidr %q: not an ip address or cidr block", s)
+		}
+		return hostCidr(ip), false, nil
+	}
+
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid cidr %q: not an ip address or cidr block", s)
+	}
+
+	cidr = n.String()
+	return cidr, !ip.Equal(n.IP), nil
+}
+
+// IsIPv6 returns true if cidr block is an IPv6 one.
+func IsIPv6(cidr string) bool {
+	ip, _, err := net.ParseCIDR(cidr)
+	return err == nil && ip.To4() == nil
+}
+
+// cidr block for just this address, /32 or /128
+func hostCidr(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
+
+// InterfaceCidrs returns a /32 or /128 for each address of given family on
+// the named local network interface, e.g. a VPN tunnel. IPv6 link-local
+// addresses are skipped, as they are no use outside the local link.
+func InterfaceCidrs(name string, family Family) ([]string, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("interface %v: %v", name, err)
+	}
+
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, fmt.Errorf("interface %v: %v", name, err)
+	}
+
+	cidrs := []string{}
+	for _, addr := range addrs {
+		var ip net.IP
+		switch a := addr.(type) {
+		case *net.IPNet:
+			ip = a.IP
+		case *net.IPAddr:
+			ip = a.IP
+		}
+		if ip == nil || ip.IsLinkLocalUnicast() || !family.Has(ip) {
+			continue
+		}
+		cidrs = append(cidrs, hostCidr(ip))
+	}
+
+	if len(cidrs) == 0 {
+		return nil, fmt.Errorf("interface %v has no usable address", name)
+	}
+	return cidrs, nil
+}
@@ -0,0 +1,65 @@
+package letmein
+
+import (
+	"net"
+	"testing"
+)
+
+func TestParseCidr(t *testing.T) {
+	for _, test := range []struct {
+		in         string
+		cidr       string
+		normalized bool
+	}{
+		{"1.2.3.4", "1.2.3.4/32", false},
+		{" 1.2.3.4 ", "1.2.3.4/32", false},
+		{"1.2.3.4/32", "1.2.3.4/32", false},
+		{"1.2.3.0/24", "1.2.3.0/24", false},
+		{"1.2.3.4/24", "1.2.3.0/24", true},
+		{"0.0.0.0/0", "0.0.0.0/0", false},
+		{"2001:db8::1", "2001:db8::1/128", false},
+		{"2001:DB8::1/128", "2001:db8::1/128", false},
+		{"2001:db8::1/64", "2001:db8::/64", true},
+	} {
+		cidr, normalized, err := ParseCidr(test.in)
+		if err != nil {
+			t.Errorf("%q: %v", test.in, err)
+			continue
+		}
+		if cidr != test.cidr || normalized != test.normalized {
+			t.Errorf("%q: expected %v %v, got %v %v", test.in, test.cidr, test.normalized, cidr, normalized)
+		}
+	}
+
+	for _, in := range []string{"", "1.2.3", "1.2.3.4/33", "1.2.3.4/", "example.com", "2001:db8::1/129", "2001:db8:::1"} {
+		if _, _, err := ParseCidr(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestInterfaceCidrs(t *testing.T) {
+	ifaces, _ := net.Interfaces()
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback == 0 {
+			continue
+		}
+		cidrs, err := InterfaceCidrs(iface.Name, IPv4)
+		if err != nil {
+			t.Skipf("loopback %v: %v", iface.Name, err)
+		}
+		if len(cidrs) != 1 || cidrs[0] != "127.0.0.1/32" {
+			t.Errorf("loopback %v: expected 127.0.0.1/32, got %v", iface.Name, cidrs)
+		}
+
+		// loopback has ::1 too, if IPv6 is enabled
+		if cidrs, err := InterfaceCidrs(iface.Name, IPv6); err == nil && (len(cidrs) != 1 || cidrs[0] != "::1/128") {
+			t.Errorf("loopback %v: expected ::1/128, got %v", iface.Name, cidrs)
+		}
+		break
+	}
+
+	if _, err := InterfaceCidrs("no-such-interface0", DualStack); err == nil {
+		t.Error("expected error for missing interface")
+	}
+}
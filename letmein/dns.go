@@ -0,0 +1,117 @@
+package letmein
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ask a DNS server for a "what is my ip" record, given as
+// dns://server[:port]/name for an A record, or with ?type=TXT for a TXT
+// record containing the address, e.g.
+//
+//	dns://resolver1.opendns.com/myip.opendns.com
+//	dns://ns1.google.com/o-o.myaddr.l.google.com?type=TXT
+func getDnsIp(ctx context.Context, source string) (net.IP, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, name, err := dnsResolver(u)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", source, err)
+	}
+
+	switch t := strings.ToUpper(u.Query().Get("type")); t {
+	case "", "A":
+		ips, err := resolver.LookupIP(ctx, "ip4", name)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", source, err)
+		}
+		return ips[0], nil
+	case "TXT":
+		records, err := resolver.LookupTXT(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", source, err)
+		}
+		for _, record := range records {
+			if ip, err := parseIp(source, record); err == nil {
+				return ip, nil
+			}
+		}
+		return nil, fmt.Errorf("%v: no address in TXT records %q", source, records)
+	default:
+		return nil, fmt.Errorf("%v: unsupported record type %v", source, t)
+	}
+}
+
+// resolver sending all queries to the server of dns://server[:port]/name,
+// rather than system resolvers, and the fully-qualified name to look up
+func dnsResolver(u *url.URL) (*net.Resolver, string, error) {
+	server := u.Host
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+
+	name := strings.TrimPrefix(u.Path, "/")
+	if name == "" {
+		return nil, "", fmt.Errorf("no query name given")
+	}
+	if !strings.HasSuffix(name, ".") {
+		name += "." // do not apply local search domains
+	}
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, server)
+		},
+	}
+	return resolver, name, nil
+}
+
+// ResolveCidrs returns a /32 for every A record of name, and a /128 for
+// every AAAA record if family includes IPv6, sorted. The name is looked up
+// with the system resolver, or may be given as dns://server[:port]/name to
+// ask a particular server.
+func ResolveCidrs(ctx context.Context, name string, family Family) ([]string, error) {
+	resolver, host := net.DefaultResolver, name
+	if strings.HasPrefix(name, "dns:") {
+		u, err := url.Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		if resolver, host, err = dnsResolver(u); err != nil {
+			return nil, fmt.Errorf("%v: %v", name, err)
+		}
+	}
+
+	network := "ip4"
+	switch family {
+	case IPv6:
+		network = "ip6"
+	case DualStack:
+		network = "ip"
+	}
+
+	ips, err := resolver.LookupIP(ctx, network, host)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", name, err)
+	}
+
+	seen := map[string]bool{}
+	cidrs := []string{}
+	for _, ip := range ips {
+		if cidr := hostCidr(ip); !seen[cidr] {
+			seen[cidr] = true
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	sort.Strings(cidrs)
+	return cidrs, nil
+}
@@ -0,0 +1,164 @@
+package letmein
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// tiny DNS server answering every A and AAAA query with addr, which may be a
+// comma-separated list, and every TXT query with txt
+func newDnsServer(t *testing.T, addr string, txt string) (string, func()) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp := dnsAnswer(buf[:n], addr, txt); resp != nil {
+				conn.WriteTo(resp, from)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String(), func() { conn.Close() }
+}
+
+// build response to query with a single answer
+func dnsAnswer(query []byte, addr string, txt string) []byte {
+	if len(query) < 12 {
+		return nil
+	}
+
+	// find end of question name
+	i := 12
+	for i < len(query) && query[i] != 0 {
+		i += int(query[i]) + 1
+	}
+	if i+5 > len(query) {
+		return nil
+	}
+	question := query[12 : i+5]
+	qtype := binary.BigEndian.Uint16(query[i+1:])
+
+	answers := [][]byte{}
+	switch qtype {
+	case 1, 28: // A, AAAA
+		for _, s := range strings.Split(addr, ",") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				continue
+			}
+			if qtype == 1 && ip.To4() != nil {
+				answers = append(answers, ip.To4())
+			}
+			if qtype == 28 && ip.To4() == nil {
+				answers = append(answers, ip.To16())
+			}
+		}
+	case 16: // TXT
+		if txt != "" {
+			answers = append(answers, append([]byte{byte(len(txt))}, txt...))
+		}
+	}
+
+	resp := make([]byte, 12)
+	copy(resp, query[:2])                        // id
+	binary.BigEndian.PutUint16(resp[2:], 0x8180) // response, recursion available
+	binary.BigEndian.PutUint16(resp[4:], 1)      // questions
+	resp = append(resp, question...)
+
+	binary.BigEndian.PutUint16(resp[6:], uint16(len(answers)))
+	for _, rdata := range answers {
+		answer := make([]byte, 12)
+		binary.BigEndian.PutUint16(answer[0:], 0xc00c) // pointer to question name
+		binary.BigEndian.PutUint16(answer[2:], qtype)
+		binary.BigEndian.PutUint16(answer[4:], 1) // class IN
+		binary.BigEndian.PutUint32(answer[6:], 60)
+		binary.BigEndian.PutUint16(answer[10:], uint16(len(rdata)))
+		resp = append(append(resp, answer...), rdata...)
+	}
+
+	return resp
+}
+
+func TestGetDnsIp(t *testing.T) {
+	server, done := newDnsServer(t, "203.0.113.7", "203.0.113.8")
+	defer done()
+
+	for _, test := range []struct {
+		source string
+		ip     string
+	}{
+		{"dns://" + server + "/myip.opendns.com", "203.0.113.7"},
+		{"dns://" + server + "/myip.opendns.com?type=A", "203.0.113.7"},
+		{"dns://" + server + "/o-o.myaddr.l.google.com?type=TXT", "203.0.113.8"},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		ip, err := GetMyIp(ctx, test.source)
+		cancel()
+
+		if err != nil {
+			t.Errorf("%v: %v", test.source, err)
+		} else if ip.String() != test.ip {
+			t.Errorf("%v: expected %v, got %v", test.source, test.ip, ip)
+		}
+	}
+}
+
+func TestGetDnsIpErrors(t *testing.T) {
+	server, done := newDnsServer(t, "", "not an address")
+	defer done()
+
+	for _, test := range []struct {
+		source string
+		err    string
+	}{
+		{"dns://" + server + "/myip.opendns.com", "no such host"},
+		{"dns://" + server + "/o-o.myaddr.l.google.com?type=TXT", "no address"},
+		{"dns://" + server + "/myip.opendns.com?type=MX", "unsupported"},
+		{"dns://" + server + "/", "no query name"},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := GetMyIp(ctx, test.source)
+		cancel()
+
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%v: expected error containing %q, got %v", test.source, test.err, err)
+		}
+	}
+}
+
+func TestResolveCidrs(t *testing.T) {
+	server, done := newDnsServer(t, "198.51.100.2,198.51.100.1,198.51.100.2,2001:db8::1", "")
+	defer done()
+	name := "dns://" + server + "/office-egress.example.com"
+
+	for _, test := range []struct {
+		family Family
+		cidrs  string
+	}{
+		{IPv4, "198.51.100.1/32 198.51.100.2/32"},
+		{IPv6, "2001:db8::1/128"},
+		{DualStack, "198.51.100.1/32 198.51.100.2/32 2001:db8::1/128"},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		cidrs, err := ResolveCidrs(ctx, name, test.family)
+		cancel()
+
+		if err != nil {
+			t.Errorf("family %v: %v", test.family, err)
+		} else if strings.Join(cidrs, " ") != test.cidrs {
+			t.Errorf("family %v: expected %v, got %v", test.family, test.cidrs, cidrs)
+		}
+	}
+}
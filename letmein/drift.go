@@ -0,0 +1,84 @@
+package letmein
+
+import (
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"net"
+)
+
+// kinds of drift from a baseline
+const (
+	DriftExtra   = "extra"   // rule not in baseline
+	DriftMissing = "missing" // baseline rule not in group
+	DriftWidened = "widened" // rule covering a baseline rule with a broader cidr or port range
+)
+
+// Drift is a single difference between the rules of a group and its
+// baseline. For widened rules, Baseline is the rule it covers.
+type Drift struct {
+	Kind     string
+	Input    Input
+	Baseline *Input
+}
+
+// DriftDiff compares the ip ranges group allows with baseline, returning
+// extra, widened and missing rules, in that order.
+func DriftDiff(group *ec2.SecurityGroup, baseline []Input) []Drift {
+	want := map[string]bool{}
+	for _, input := range baseline {
+		want[inputKey(input)] = true
+	}
+
+	drifts := []Drift{}
+	have := map[string]bool{}
+	for _, perm := range group.IpPermissions {
+		for _, cidr := range Ranges(perm) {
+			key := permKey(perm, cidr)
+			have[key] = true
+			if want[key] {
+				continue
+			}
+
+			input := permInput(perm, cidr)
+			drift := Drift{Kind: DriftExtra, Input: input}
+			for i := range baseline {
+				if covers(input, baseline[i]) {
+					drift = Drift{Kind: DriftWidened, Input: input, Baseline: &baseline[i]}
+					break
+				}
+			}
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for _, input := range baseline {
+		if key := inputKey(input); !have[key] {
+			drifts = append(drifts, Drift{Kind: DriftMissing, Input: input})
+			have[key] = true
+		}
+	}
+
+	return drifts
+}
+
+// true if rule a allows everything rule b does, on the same protocol, or
+// on any protocol if a allows all traffic
+func covers(a, b Input) bool {
+	if aws.StringValue(a.IpProtocol) != "-1" && (aws.StringValue(a.IpProtocol) != aws.StringValue(b.IpProtocol) ||
+		aws.Int64Value(a.FromPort) > aws.Int64Value(b.FromPort) || aws.Int64Value(a.ToPort) < aws.Int64Value(b.ToPort)) {
+		return false
+	}
+
+	_, an, err := net.ParseCIDR(a.Cidr())
+	if err != nil {
+		return false
+	}
+	_, bn, err := net.ParseCIDR(b.Cidr())
+	if err != nil {
+		return false
+	}
+
+	aOnes, aBits := an.Mask.Size()
+	bOnes, bBits := bn.Mask.Size()
+	return aBits == bBits && aOnes <= bOnes && an.Contains(bn.IP)
+}
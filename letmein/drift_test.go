@@ -0,0 +1,87 @@
+package letmein
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"testing"
+)
+
+func TestDriftDiff(t *testing.T) {
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "10.0.0.0/8")
+	f.add("sg-0", "tcp", 22, "198.51.100.7/32")
+	f.add("sg-0", "tcp", 443, "0.0.0.0/0")
+	groups, _ := GetGroups(context.Background(), f, []string{"web"}, "group-name")
+
+	baseline := []Input{sshInput("10.0.0.0/8"), NewInput("tcp", 443, "203.0.113.0/24"), NewInput("tcp", 443, "2001:db8::/64")}
+	drifts := DriftDiff(groups[0], baseline)
+
+	expected := []struct{ kind, cidr string }{
+		{DriftExtra, "198.51.100.7/32"},
+		{DriftWidened, "0.0.0.0/0"},
+		{DriftMissing, "203.0.113.0/24"},
+		{DriftMissing, "2001:db8::/64"},
+	}
+	if len(drifts) != len(expected) {
+		t.Fatalf("expected %d drifts, got %v", len(expected), drifts)
+	}
+	for i, e := range expected {
+		if drifts[i].Kind != e.kind || drifts[i].Input.Cidr() != e.cidr {
+			t.Errorf("drift %d: expected %v %v, got %v %v", i, e.kind, e.cidr, drifts[i].Kind, drifts[i].Input.Cidr())
+		}
+	}
+	if b := drifts[1].Baseline; b == nil || b.Cidr() != "203.0.113.0/24" {
+		t.Errorf("expected widened rule to cover 203.0.113.0/24, got %v", b)
+	}
+
+	if drifts := DriftDiff(groups[0], []Input{sshInput("10.0.0.0/8"), sshInput("198.51.100.7/32"), NewInput("tcp", 443, "0.0.0.0/0")}); len(drifts) != 0 {
+		t.Errorf("expected no drift, got %v", drifts)
+	}
+}
+
+func TestDriftDiffAllTraffic(t *testing.T) {
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "10.1.0.0/16")
+	groups, _ := GetGroups(context.Background(), f, []string{"web"}, "group-name")
+
+	// as EC2 gives them, without ports
+	groups[0].IpPermissions = append(groups[0].IpPermissions, &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("192.0.2.0/24")}, {CidrIp: aws.String("10.0.0.0/8")}},
+	})
+
+	rules, err := ParseRuleSet([]byte("groups:\n  - name: web\n    ingress:\n      - protocol: -1\n        cidrs: [192.0.2.0/24]\n      - port: 22\n        cidrs: [10.1.0.0/16]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseline := rules.Groups[0].Inputs()
+
+	// approved all traffic rule is not extra, one on a wider cidr widens ssh
+	drifts := DriftDiff(groups[0], baseline)
+	if len(drifts) != 1 || drifts[0].Kind != DriftWidened || drifts[0].Input.Cidr() != "10.0.0.0/8" || drifts[0].Baseline.Cidr() != "10.1.0.0/16" {
+		t.Errorf("expected all traffic from 10.0.0.0/8 to widen ssh, got %v", drifts)
+	}
+}
+
+func TestCovers(t *testing.T) {
+	for _, test := range []struct {
+		a, b   Input
+		covers bool
+	}{
+		{sshInput("10.0.0.0/8"), sshInput("10.1.0.0/16"), true},
+		{sshInput("10.1.0.0/16"), sshInput("10.0.0.0/8"), false},
+		{sshInput("0.0.0.0/0"), sshInput("2001:db8::/64"), false},
+		{sshInput("2001:db8::/32"), sshInput("2001:db8::/64"), true},
+		{NewInput("udp", 22, "10.0.0.0/8"), sshInput("10.1.0.0/16"), false},
+		{NewInput("tcp", 443, "10.1.0.0/16"), sshInput("10.1.0.0/16"), false},
+		{NewInput("-1", -1, "10.0.0.0/8"), NewInput("udp", 53, "10.1.0.0/16"), true},
+		{NewInput("-1", -1, "10.1.0.0/16"), sshInput("10.1.0.0/16"), true},
+		{NewInput("-1", -1, "10.1.0.0/16"), sshInput("10.0.0.0/8"), false},
+		{sshInput("0.0.0.0/0"), NewInput("-1", -1, "10.0.0.0/8"), false},
+	} {
+		if covers(test.a, test.b) != test.covers {
+			t.Errorf("%v covers %v: expected %v", test.a.Cidr(), test.b.Cidr(), test.covers)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package letmein
+
+import (
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// in-memory EC2 holding security groups; methods we do not fake will panic
+type fakeEC2 struct {
+	ec2iface.EC2API
+	groups []*ec2.SecurityGroup
+	calls  []string
+	err    error // if set, returned by every call
+}
+
+// new fake with a group for each name, with ids sg-0, sg-1, etc
+func newFakeEC2(names ...string) *fakeEC2 {
+	f := &fakeEC2{}
+	for i, name := range names {
+		f.groups = append(f.groups, &ec2.SecurityGroup{
+			GroupId:   aws.String(fmt.Sprintf("sg-%d", i)),
+			GroupName: aws.String(name),
+		})
+	}
+	return f
+}
+
+func (f *fakeEC2) group(id string) *ec2.SecurityGroup {
+	for _, g := range f.groups {
+		if *g.GroupId == id {
+			return g
+		}
+	}
+	return nil
+}
+
+// add a rule directly, without recording a call
+func (f *fakeEC2) add(id, protocol string, port int64, cidr string) {
+	f.addDescribed(id, protocol, port, cidr, "")
+}
+
+// add a rule with a description directly, without recording a call
+func (f *fakeEC2) addDescribed(id, protocol string, port int64, cidr, description string) {
+	var desc *string
+	if description != "" {
+		desc = aws.String(description)
+	}
+
+	g := f.group(id)
+	perm := findPerm(g, protocol, port)
+	if perm == nil {
+		perm = &ec2.IpPermission{IpProtocol: aws.String(protocol), FromPort: aws.Int64(port), ToPort: aws.Int64(port)}
+		g.IpPermissions = append(g.IpPermissions, perm)
+	}
+	if IsIPv6(cidr) {
+		perm.Ipv6Ranges = append(perm.Ipv6Ranges, &ec2.Ipv6Range{CidrIpv6: aws.String(cidr), Description: desc})
+	} else {
+		perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{CidrIp: aws.String(cidr), Description: desc})
+	}
+}
+
+// remove a rule, returning false if it did not exist
+func (f *fakeEC2) remove(id, protocol string, port int64, cidr string) bool {
+	perm := findPerm(f.group(id), protocol, port)
+	if perm == nil {
+		return false
+	}
+	for i, r := range perm.IpRanges {
+		if *r.CidrIp == cidr {
+			perm.IpRanges = append(perm.IpRanges[:i], perm.IpRanges[i+1:]...)
+			return true
+		}
+	}
+	for i, r := range perm.Ipv6Ranges {
+		if *r.CidrIpv6 == cidr {
+			perm.Ipv6Ranges = append(perm.Ipv6Ranges[:i], perm.Ipv6Ranges[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// true if group has rule
+func (f *fakeEC2) has(id, protocol string, port int64, cidr string) bool {
+	perm := findPerm(f.group(id), protocol, port)
+	if perm == nil {
+		return false
+	}
+	for _, c := range Ranges(perm) {
+		if c == cidr {
+			return true
+		}
+	}
+	return false
+}
+
+// number of cidrs in all rules of group
+func (f *fakeEC2) count(id string) int {
+	n := 0
+	for _, perm := range f.group(id).IpPermissions {
+		n += len(perm.IpRanges) + len(perm.Ipv6Ranges)
+	}
+	return n
+}
+
+func findPerm(g *ec2.SecurityGroup, protocol string, port int64) *ec2.IpPermission {
+	for _, perm := range g.IpPermissions {
+		if *perm.IpProtocol == protocol && *perm.FromPort == port && *perm.ToPort == port {
+			return perm
+		}
+	}
+	return nil
+}
+
+// copy of group, so callers cannot see later changes
+func copyGroup(g *ec2.SecurityGroup) *ec2.SecurityGroup {
+	c := *g
+	c.IpPermissions = nil
+	for _, perm := range g.IpPermissions {
+		p := *perm
+		p.IpRanges = append([]*ec2.IpRange{}, perm.IpRanges...)
+		p.Ipv6Ranges = append([]*ec2.Ipv6Range{}, perm.Ipv6Ranges...)
+		c.IpPermissions = append(c.IpPermissions, &p)
+	}
+	return &c
+}
+
+func (f *fakeEC2) DescribeSecurityGroups(in *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+	f.calls = append(f.calls, "DescribeSecurityGroups")
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	out := &ec2.DescribeSecurityGroupsOutput{}
+	for _, g := range f.groups {
+		if matchesFilters(g, in.Filters) {
+			out.SecurityGroups = append(out.SecurityGroups, copyGroup(g))
+		}
+	}
+	return out, nil
+}
+
+func matchesFilters(g *ec2.SecurityGroup, filters []*ec2.Filter) bool {
+	for _, filter := range filters {
+		var value string
+		switch *filter.Name {
+		case "group-name":
+			value = *g.GroupName
+		case "group-id":
+			value = *g.GroupId
+		default:
+			panic("fake does not support filter " + *filter.Name)
+		}
+
+		found := false
+		for _, v := range filter.Values {
+			if *v == value {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// single rules of request, given as flat params or as IpPermissions
+func requestRules(protocol *string, from *int64, cidr *string, perms []*ec2.IpPermission) []Input {
+	if cidr != nil {
+		return []Input{{IpProtocol: protocol, FromPort: from, CidrIp: cidr}}
+	}
+
+	rules := []Input{}
+	for _, perm := range perms {
+		for _, c := range Ranges(perm) {
+			rules = append(rules, permInput(perm, c))
+		}
+	}
+	return rules
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupIngress(in *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	f.calls = append(f.calls, "AuthorizeSecurityGroupIngress")
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	g := f.group(*in.GroupId)
+	if g == nil {
+		return nil, awserr.New("InvalidGroup.NotFound", "no such group", nil)
+	}
+
+	rules := requestRules(in.IpProtocol, in.FromPort, in.CidrIp, in.IpPermissions)
+	for _, r := range rules {
+		if f.has(*in.GroupId, *r.IpProtocol, *r.FromPort, r.Cidr()) {
+			return nil, awserr.New("InvalidPermission.Duplicate", "rule already exists", nil)
+		}
+	}
+
+	for _, r := range rules {
+		f.addDescribed(*in.GroupId, *r.IpProtocol, *r.FromPort, r.Cidr(), aws.StringValue(r.Description))
+	}
+	return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+}
+
+func (f *fakeEC2) RevokeSecurityGroupIngress(in *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	f.calls = append(f.calls, "RevokeSecurityGroupIngress")
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	g := f.group(*in.GroupId)
+	if g == nil {
+		return nil, awserr.New("InvalidGroup.NotFound", "no such group", nil)
+	}
+
+	rules := requestRules(in.IpProtocol, in.FromPort, in.CidrIp, in.IpPermissions)
+	for _, r := range rules {
+		if !f.has(*in.GroupId, *r.IpProtocol, *r.FromPort, r.Cidr()) {
+			return nil, awserr.New("InvalidPermission.NotFound", "no such rule", nil)
+		}
+	}
+
+	for _, r := range rules {
+		f.remove(*in.GroupId, *r.IpProtocol, *r.FromPort, r.Cidr())
+	}
+	return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+}
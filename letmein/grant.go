@@ -0,0 +1,127 @@
+package letmein
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// longest rule description EC2 allows
+const maxDescription = 255
+
+// longest sync source kept as is in descriptions; longer ones are hashed
+const maxSyncSource = 64
+
+// Grant is what let-me-in records about a rule it creates, kept in the rule
+// description as key=value fields after Description, e.g.
+// "let-me-in by=alice@laptop at=2006-01-02T15:04:05Z ticket=OPS-1 reason=db migration".
+// The reason comes last, as it may contain spaces.
+type Grant struct {
+	User   string
+	Host   string
+	Time   time.Time
+	Ticket string
+	Sync   string // source of the addresses, for rules made by sync, as syncMarker when parsed
+	Reason string
+}
+
+// Owner returns user@host of grant, or just the user if host is unknown.
+func (g Grant) Owner() string {
+	if g.Host == "" {
+		return g.User
+	}
+	return g.User + "@" + g.Host
+}
+
+// Description returns the rule description recording grant. Characters EC2
+// does not allow in descriptions are replaced, and long fields cut short.
+// The sync source comes first, so it is never cut.
+func (g Grant) Description() string {
+	fields := []string{Description}
+	if g.Sync != "" {
+		fields = append(fields, "sync="+syncMarker(g.Sync))
+	}
+	if g.User != "" {
+		fields = append(fields, "by="+token(g.Owner()))
+	}
+	if !g.Time.IsZero() {
+		fields = append(fields, "at="+g.Time.UTC().Format(time.RFC3339))
+	}
+	if g.Ticket != "" {
+		fields = append(fields, "ticket="+token(g.Ticket))
+	}
+	if g.Reason != "" {
+		fields = append(fields, "reason="+sanitize(g.Reason))
+	}
+
+	d := strings.Join(fields, " ")
+	if len(d) > maxDescription {
+		d = d[:maxDescription]
+	}
+	return d
+}
+
+// ParseGrant returns the grant recorded in a rule description, and false
+// if the rule was not created by let-me-in. Unknown fields are ignored.
+func ParseGrant(description string) (Grant, bool) {
+	grant := Grant{}
+	if !IsLetMeIn(description) {
+		return grant, false
+	}
+
+	rest := strings.TrimPrefix(description, Description)
+	if i := strings.Index(rest, " reason="); i >= 0 {
+		grant.Reason = rest[i+len(" reason="):]
+		rest = rest[:i]
+	}
+
+	for _, field := range strings.Fields(rest) {
+		i := strings.Index(field, "=")
+		if i < 0 {
+			continue
+		}
+		value := field[i+1:]
+		switch field[:i] {
+		case "by":
+			if at := strings.LastIndex(value, "@"); at >= 0 {
+				grant.User, grant.Host = value[:at], value[at+1:]
+			} else {
+				grant.User = value
+			}
+		case "at":
+			grant.Time, _ = time.Parse(time.RFC3339, value)
+		case "ticket":
+			grant.Ticket = value
+		case "sync":
+			grant.Sync = value
+		}
+	}
+	return grant, true
+}
+
+// s with characters not allowed in descriptions replaced by _
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', strings.ContainsRune(". _-:/()#,@[]+=&;{}!$*", r):
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// sync source as recorded in descriptions: as is if short and allowed in
+// descriptions, otherwise a short hash, so that it fits and is never mangled
+func syncMarker(source string) string {
+	if len(source) <= maxSyncSource && token(source) == source {
+		return source
+	}
+	sum := sha256.Sum256([]byte(source))
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// s as a single field, without spaces
+func token(s string) string {
+	return strings.Replace(sanitize(s), " ", "_", -1)
+}
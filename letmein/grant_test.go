@@ -0,0 +1,42 @@
+package letmein
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseGrant(t *testing.T) {
+	at := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	grant := Grant{User: "alice", Host: "laptop", Time: at, Ticket: "OPS-123", Reason: "db migration, see runbook"}
+	d := grant.Description()
+	if d != "let-me-in by=alice@laptop at=2026-01-02T15:04:05Z ticket=OPS-123 reason=db migration, see runbook" {
+		t.Errorf("unexpected description %q", d)
+	}
+
+	for _, test := range []struct {
+		description string
+		ok          bool
+		grant       Grant
+	}{
+		{d, true, grant},
+		{"let-me-in", true, Grant{}},
+		{"let-me-in at=2026-01-02T15:04:05Z", true, Grant{Time: at}},
+		{"let-me-in by=ci at=yesterday future=1", true, Grant{User: "ci"}},
+		{"let-me-in sync=https://example.com/ips by=ci", true, Grant{User: "ci", Sync: "https://example.com/ips"}},
+		{"office vpn", false, Grant{}},
+		{"", false, Grant{}},
+	} {
+		grant, ok := ParseGrant(test.description)
+		if ok != test.ok || grant != test.grant {
+			t.Errorf("%q: expected %v %v, got %v %v", test.description, test.grant, test.ok, grant, ok)
+		}
+	}
+}
+
+func TestGrantDescriptionSanitized(t *testing.T) {
+	d := Grant{User: "bob smith", Reason: `"urgent" fix ` + strings.Repeat("x", 300)}.Description()
+	if !strings.HasPrefix(d, "let-me-in by=bob_smith reason=_urgent_ fix xxx") || len(d) != maxDescription {
+		t.Errorf("unexpected description %q", d)
+	}
+}
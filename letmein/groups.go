@@ -0,0 +1,299 @@
+// Package letmein adds and removes ingress rules for your public ip in
+// EC2 security groups. It is the library behind the let-me-in command.
+package letmein
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"strings"
+)
+
+// Description marks rules created by let-me-in, as the start of the rule
+// description.
+const Description = "let-me-in"
+
+// Input is a single ip permission to authorize or revoke, for either an
+// IPv4 or IPv6 cidr block.
+type Input struct {
+	GroupId     *string
+	IpProtocol  *string
+	FromPort    *int64
+	ToPort      *int64
+	CidrIp      *string
+	CidrIpv6    *string
+	Description *string // of rule when authorized, not used to revoke
+}
+
+// IsLetMeIn returns true if rule description says it was created by let-me-in.
+func IsLetMeIn(description string) bool {
+	return strings.HasPrefix(description, Description)
+}
+
+// NewInput returns input for protocol on a single port, setting CidrIp or
+// CidrIpv6 depending on the family of cidr.
+func NewInput(protocol string, port int64, cidr string) Input {
+	input := Input{
+		IpProtocol: aws.String(protocol),
+		FromPort:   aws.Int64(port),
+		ToPort:     aws.Int64(port),
+	}
+	input.setCidr(cidr)
+	return input
+}
+
+// input for one cidr block of existing permission
+func permInput(perm *ec2.IpPermission, cidr string) Input {
+	input := Input{
+		IpProtocol: perm.IpProtocol,
+		FromPort:   perm.FromPort,
+		ToPort:     perm.ToPort,
+	}
+	input.setCidr(cidr)
+	if d := RangeDescription(perm, cidr); d != "" {
+		input.Description = aws.String(d)
+	}
+	return input
+}
+
+func (i *Input) setCidr(cidr string) {
+	if IsIPv6(cidr) {
+		i.CidrIpv6 = aws.String(cidr)
+	} else {
+		i.CidrIp = aws.String(cidr)
+	}
+}
+
+// Cidr returns the IPv4 or IPv6 cidr block of input.
+func (i Input) Cidr() string {
+	if i.CidrIpv6 != nil {
+		return *i.CidrIpv6
+	}
+	return aws.StringValue(i.CidrIp)
+}
+
+// WithCidr returns a copy of input for another cidr block.
+func (i Input) WithCidr(cidr string) Input {
+	i.CidrIp, i.CidrIpv6 = nil, nil
+	i.setCidr(cidr)
+	return i
+}
+
+// input as the permission sent to EC2; IPv6 ranges can only be given this way
+func (i Input) permissions() []*ec2.IpPermission {
+	perm := &ec2.IpPermission{
+		IpProtocol: i.IpProtocol,
+		FromPort:   i.FromPort,
+		ToPort:     i.ToPort,
+	}
+	if i.CidrIpv6 != nil {
+		perm.Ipv6Ranges = []*ec2.Ipv6Range{{CidrIpv6: i.CidrIpv6, Description: i.Description}}
+	} else {
+		perm.IpRanges = []*ec2.IpRange{{CidrIp: i.CidrIp, Description: i.Description}}
+	}
+	return []*ec2.IpPermission{perm}
+}
+
+// Ranges returns all IPv4 and IPv6 cidr blocks of permission.
+func Ranges(perm *ec2.IpPermission) []string {
+	cidrs := []string{}
+	for _, r := range perm.IpRanges {
+		cidrs = append(cidrs, aws.StringValue(r.CidrIp))
+	}
+	for _, r := range perm.Ipv6Ranges {
+		cidrs = append(cidrs, aws.StringValue(r.CidrIpv6))
+	}
+	return cidrs
+}
+
+// RangeDescription returns the description of cidr block in permission.
+func RangeDescription(perm *ec2.IpPermission, cidr string) string {
+	for _, r := range perm.IpRanges {
+		if aws.StringValue(r.CidrIp) == cidr {
+			return aws.StringValue(r.Description)
+		}
+	}
+	for _, r := range perm.Ipv6Ranges {
+		if aws.StringValue(r.CidrIpv6) == cidr {
+			return aws.StringValue(r.Description)
+		}
+	}
+	return ""
+}
+
+// GetGroups returns security groups for given names, by filter (group-name, group-id, tag:Name, etc).
+func GetGroups(ctx context.Context, client ec2iface.EC2API, names []string, filter string) ([]*ec2.SecurityGroup, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// get names as array of aws.String objects
+	values := make([]*string, len(names))
+	for i, name := range names {
+		values[i] = aws.String(name)
+	}
+
+	// request params as filter for names
+	params := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(filter),
+				Values: values,
+			},
+		},
+	}
+
+	// send request
+	resp, err := client.DescribeSecurityGroups(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.SecurityGroups, nil
+}
+
+// AllGroups returns every security group in the region of client.
+func AllGroups(ctx context.Context, client ec2iface.EC2API) ([]*ec2.SecurityGroup, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.SecurityGroups, nil
+}
+
+// AuthorizeGroups adds permission to each of groups, stopping at the first error.
+func AuthorizeGroups(ctx context.Context, client ec2iface.EC2API, groups []*ec2.SecurityGroup, input Input) error {
+	for _, group := range groups {
+		if err := AuthorizeGroup(ctx, client, group, input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuthorizeGroup adds permission to security group. It is not an error
+// if the permission already exists.
+func AuthorizeGroup(ctx context.Context, client ec2iface.EC2API, group *ec2.SecurityGroup, input Input) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	_, err := client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       group.GroupId,
+		IpPermissions: input.permissions(),
+	})
+
+	// be idempotent, i.e. skip error if this permission already exists in group
+	if isCode(err, "InvalidPermission.Duplicate") {
+		return nil
+	}
+	return err
+}
+
+// RevokeGroups removes permission from each of groups, stopping at the first error.
+func RevokeGroups(ctx context.Context, client ec2iface.EC2API, groups []*ec2.SecurityGroup, input Input) error {
+	for _, group := range groups {
+		if err := RevokeGroup(ctx, client, group, input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeGroup removes permission from security group. It is not an error
+// if the permission does not exist.
+func RevokeGroup(ctx context.Context, client ec2iface.EC2API, group *ec2.SecurityGroup, input Input) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	input.Description = nil // rules are matched without it
+	_, err := client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId:       group.GroupId,
+		IpPermissions: input.permissions(),
+	})
+
+	// be idempotent, i.e. skip error if this permission is already gone from group
+	if isCode(err, "InvalidPermission.NotFound") {
+		return nil
+	}
+	return err
+}
+
+// CleanGroups revokes all ip permissions from each of groups.
+func CleanGroups(ctx context.Context, client ec2iface.EC2API, groups []*ec2.SecurityGroup) error {
+	for _, group := range groups {
+		if err := CleanGroup(ctx, client, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanGroup revokes all existing ip permissions for security group.
+func CleanGroup(ctx context.Context, client ec2iface.EC2API, group *ec2.SecurityGroup) error {
+	for _, perm := range group.IpPermissions {
+		for _, cidr := range Ranges(perm) {
+			if err := RevokeGroup(ctx, client, group, permInput(perm, cidr)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MatchingGroups returns copies of groups containing only the ip ranges
+// that match any of cidrs.
+func MatchingGroups(groups []*ec2.SecurityGroup, cidrs ...string) []*ec2.SecurityGroup {
+	match := map[string]bool{}
+	for _, cidr := range cidrs {
+		match[cidr] = true
+	}
+
+	return FilterGroups(groups, func(perm *ec2.IpPermission, cidr string) bool {
+		return match[cidr]
+	})
+}
+
+// FilterGroups returns copies of groups containing only the IPv4 and IPv6
+// ranges for which keep returns true.
+func FilterGroups(groups []*ec2.SecurityGroup, keep func(perm *ec2.IpPermission, cidr string) bool) []*ec2.SecurityGroup {
+	matches := make([]*ec2.SecurityGroup, len(groups))
+	for i, group := range groups {
+		matches[i] = &ec2.SecurityGroup{GroupId: group.GroupId, GroupName: group.GroupName, Tags: group.Tags}
+		for _, perm := range group.IpPermissions {
+			match := &ec2.IpPermission{
+				IpProtocol: perm.IpProtocol,
+				FromPort:   perm.FromPort,
+				ToPort:     perm.ToPort,
+			}
+			for _, r := range perm.IpRanges {
+				if keep(perm, aws.StringValue(r.CidrIp)) {
+					match.IpRanges = append(match.IpRanges, r)
+				}
+			}
+			for _, r := range perm.Ipv6Ranges {
+				if keep(perm, aws.StringValue(r.CidrIpv6)) {
+					match.Ipv6Ranges = append(match.Ipv6Ranges, r)
+				}
+			}
+			if len(match.IpRanges)+len(match.Ipv6Ranges) > 0 {
+				matches[i].IpPermissions = append(matches[i].IpPermissions, match)
+			}
+		}
+	}
+	return matches
+}
+
+// true if err is an aws error with given code
+func isCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
@@ -0,0 +1,188 @@
+package letmein
+
+import (
+	"context"
+	"errors"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/awserr"
+	"testing"
+)
+
+func sshInput(cidr string) Input {
+	return NewInput("tcp", 22, cidr)
+}
+
+func TestGetGroups(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web", "db", "cache")
+
+	groups, err := GetGroups(ctx, f, []string{"web", "cache", "missing"}, "group-name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 || *groups[0].GroupId != "sg-0" || *groups[1].GroupId != "sg-2" {
+		t.Errorf("got wrong groups: %v", groups)
+	}
+
+	groups, err = GetGroups(ctx, f, []string{"sg-1"}, "group-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 1 || *groups[0].GroupName != "db" {
+		t.Errorf("got wrong groups: %v", groups)
+	}
+}
+
+func TestAuthorizeGroupDuplicate(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	for i := 0; i < 2; i++ {
+		if err := AuthorizeGroup(ctx, f, groups[0], sshInput("1.2.3.4/32")); err != nil {
+			t.Fatalf("authorize %d: %v", i, err)
+		}
+	}
+
+	if !f.has("sg-0", "tcp", 22, "1.2.3.4/32") {
+		t.Error("rule not added")
+	}
+	if f.count("sg-0") != 1 {
+		t.Errorf("expected 1 rule, got %d", f.count("sg-0"))
+	}
+}
+
+func TestRevokeGroupNotFound(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "1.2.3.4/32")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	for i := 0; i < 2; i++ {
+		if err := RevokeGroup(ctx, f, groups[0], sshInput("1.2.3.4/32")); err != nil {
+			t.Fatalf("revoke %d: %v", i, err)
+		}
+	}
+
+	if f.count("sg-0") != 0 {
+		t.Errorf("expected no rules, got %d", f.count("sg-0"))
+	}
+}
+
+func TestAuthorizeGroupIPv6(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	input := sshInput("2001:db8::1/128")
+	if input.CidrIp != nil || *input.CidrIpv6 != "2001:db8::1/128" {
+		t.Fatalf("expected IPv6 input, got %v", input)
+	}
+
+	if err := AuthorizeGroup(ctx, f, groups[0], input); err != nil {
+		t.Fatal(err)
+	}
+	groups, _ = GetGroups(ctx, f, []string{"web"}, "group-name")
+	perm := groups[0].IpPermissions[0]
+	if len(perm.IpRanges) != 0 || len(perm.Ipv6Ranges) != 1 || *perm.Ipv6Ranges[0].CidrIpv6 != "2001:db8::1/128" {
+		t.Errorf("expected IPv6 range, got %v", perm)
+	}
+
+	if err := RevokeGroup(ctx, f, groups[0], input); err != nil {
+		t.Fatal(err)
+	}
+	if f.count("sg-0") != 0 {
+		t.Errorf("expected no rules, got %d", f.count("sg-0"))
+	}
+}
+
+func TestAuthorizeGroupsError(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web", "db")
+	groups, _ := GetGroups(ctx, f, []string{"web", "db"}, "group-name")
+
+	f.err = awserr.New("UnauthorizedOperation", "not allowed", nil)
+	f.calls = nil
+
+	err := AuthorizeGroups(ctx, f, groups, sshInput("1.2.3.4/32"))
+	if !isCode(err, "UnauthorizedOperation") {
+		t.Errorf("expected UnauthorizedOperation, got %v", err)
+	}
+	if len(f.calls) != 1 {
+		t.Errorf("expected to stop after first error, got calls %v", f.calls)
+	}
+
+	f.err = errors.New("connection refused")
+	if err := RevokeGroups(ctx, f, groups, sshInput("1.2.3.4/32")); err != f.err {
+		t.Errorf("expected non-aws error to be returned, got %v", err)
+	}
+}
+
+func TestCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := newFakeEC2("web")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+	cancel()
+	f.calls = nil
+
+	if err := AuthorizeGroups(ctx, f, groups, sshInput("1.2.3.4/32")); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if len(f.calls) != 0 {
+		t.Errorf("expected no calls, got %v", f.calls)
+	}
+}
+
+func TestCleanGroup(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web", "db")
+	f.add("sg-0", "tcp", 22, "1.2.3.4/32")
+	f.add("sg-0", "tcp", 22, "5.6.7.0/24")
+	f.add("sg-0", "tcp", 443, "0.0.0.0/0")
+	f.add("sg-0", "udp", 53, "10.0.0.0/8")
+	f.add("sg-0", "tcp", 22, "2001:db8::/64")
+	f.add("sg-1", "tcp", 5432, "1.2.3.4/32")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	if err := CleanGroup(ctx, f, groups[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := f.count("sg-0"); n != 0 {
+		t.Errorf("expected no rules left, got %d", n)
+	}
+	if !f.has("sg-1", "tcp", 5432, "1.2.3.4/32") {
+		t.Error("clean touched another group")
+	}
+
+	// group is now stale, cleaning again should be harmless
+	if err := CleanGroup(ctx, f, groups[0]); err != nil {
+		t.Errorf("clean of stale group: %v", err)
+	}
+}
+
+func TestMatchingGroups(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "1.2.3.4/32")
+	f.add("sg-0", "tcp", 22, "5.6.7.8/32")
+	f.add("sg-0", "tcp", 443, "1.2.3.4/32")
+	f.add("sg-0", "tcp", 80, "0.0.0.0/0")
+	f.add("sg-0", "tcp", 22, "2001:db8::1/128")
+	f.add("sg-0", "tcp", 80, "::/0")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	matches := MatchingGroups(groups, "1.2.3.4/32")
+	if len(matches) != 1 || len(matches[0].IpPermissions) != 2 {
+		t.Fatalf("expected 2 matching permissions, got %v", matches)
+	}
+	for _, perm := range matches[0].IpPermissions {
+		if len(perm.IpRanges) != 1 || *perm.IpRanges[0].CidrIp != "1.2.3.4/32" || len(perm.Ipv6Ranges) != 0 {
+			t.Errorf("unexpected ranges: %v", perm)
+		}
+	}
+
+	matches = MatchingGroups(groups, "1.2.3.4/32", "2001:db8::1/128")
+	if perm := matches[0].IpPermissions[0]; len(perm.IpRanges) != 1 || len(perm.Ipv6Ranges) != 1 || *perm.Ipv6Ranges[0].CidrIpv6 != "2001:db8::1/128" {
+		t.Errorf("expected both families on port 22, got %v", perm)
+	}
+}
@@ -0,0 +1,193 @@
+package letmein
+
+import (
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tags on security groups limiting what let-me-in may do to them
+const (
+	OptInTag       = "let-me-in"              // set to OptInEnabled to allow changes, if policy requires opt in
+	OptInEnabled   = "enabled"                //
+	PortsTag       = "let-me-in:ports"        // ports that may be opened, e.g. 22, 8000-8080
+	ProtocolsTag   = "let-me-in:protocols"    // protocols that may be opened, e.g. tcp, udp
+	MaxDurationTag = "let-me-in:max-duration" // longest access allowed, e.g. 1h
+)
+
+// CheckGroup returns an error if policy only allows changes to groups that
+// opt in, and group is not tagged to do so.
+func (p *Policy) CheckGroup(group *ec2.SecurityGroup) error {
+	if !p.OptIn {
+		return nil
+	}
+	if value, _ := groupTag(group, OptInTag); value != OptInEnabled {
+		return fmt.Errorf("group %v is not tagged %v=%v, refusing to change it", groupName(group), OptInTag, OptInEnabled)
+	}
+	return nil
+}
+
+// CheckGroupInput returns an error if input may not be authorized in group,
+// because it has not opted in, or its tags do not allow the protocol or
+// ports of input.
+func (p *Policy) CheckGroupInput(group *ec2.SecurityGroup, input Input) error {
+	if err := p.CheckGroup(group); err != nil {
+		return err
+	}
+
+	protocol, from, to := aws.StringValue(input.IpProtocol), aws.Int64Value(input.FromPort), aws.Int64Value(input.ToPort)
+
+	if value, ok := groupTag(group, ProtocolsTag); ok {
+		allowed := false
+		for _, p := range strings.Split(value, ",") {
+			allowed = allowed || strings.TrimSpace(p) == protocol
+		}
+		if !allowed {
+			return fmt.Errorf("group %v only allows %v, not %v", groupName(group), value, protocol)
+		}
+	}
+
+	if value, ok := groupTag(group, PortsTag); ok && protocol != "icmp" {
+		allowed := false
+		for _, s := range strings.Split(value, ",") {
+			lo, hi, err := parsePorts(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("group %v: invalid %v tag %q", groupName(group), PortsTag, value)
+			}
+			allowed = allowed || (lo <= from && to <= hi)
+		}
+		if !allowed {
+			return fmt.Errorf("group %v only allows ports %v, not %v", groupName(group), value, portRange(protocol, from, to))
+		}
+	}
+
+	return nil
+}
+
+// MaxDuration returns the longest access group allows by its tag, or 0 if
+// there is no limit.
+func MaxDuration(group *ec2.SecurityGroup) (time.Duration, error) {
+	value, ok := groupTag(group, MaxDurationTag)
+	if !ok {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("group %v: invalid %v tag %q", groupName(group), MaxDurationTag, value)
+	}
+	return d, nil
+}
+
+// port or range of ports, e.g. 22 or 8000-8080
+func parsePorts(s string) (int64, int64, error) {
+	parts := strings.SplitN(s, "-", 2)
+	lo, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	hi := lo
+	if len(parts) == 2 {
+		if hi, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+			return 0, 0, err
+		}
+	}
+	return lo, hi, nil
+}
+
+// value of tag on group, and whether it is set
+func groupTag(group *ec2.SecurityGroup, key string) (string, bool) {
+	for _, tag := range group.Tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value), true
+		}
+	}
+	return "", false
+}
+
+// name of group for messages, or its id
+func groupName(group *ec2.SecurityGroup) string {
+	if group.GroupName != nil {
+		return *group.GroupName
+	}
+	return aws.StringValue(group.GroupId)
+}
+
+// NewGuardClient wraps client so that it refuses to authorize or revoke
+// rules in groups that policy and their tags do not allow changing, however
+// the change is requested. Groups are looked up by id if they have not been
+// described through the client already.
+func NewGuardClient(client ec2iface.EC2API, policy *Policy) ec2iface.EC2API {
+	return &guardClient{EC2API: client, policy: policy, groups: map[string]*ec2.SecurityGroup{}}
+}
+
+type guardClient struct {
+	ec2iface.EC2API
+	policy *Policy
+
+	mu     sync.Mutex
+	groups map[string]*ec2.SecurityGroup // by id
+}
+
+func (c *guardClient) DescribeSecurityGroups(in *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+	out, err := c.EC2API.DescribeSecurityGroups(in)
+	if err == nil {
+		c.mu.Lock()
+		for _, group := range out.SecurityGroups {
+			c.groups[aws.StringValue(group.GroupId)] = group
+		}
+		c.mu.Unlock()
+	}
+	return out, err
+}
+
+func (c *guardClient) AuthorizeSecurityGroupIngress(in *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	group, err := c.group(in.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, perm := range in.IpPermissions {
+		for _, cidr := range Ranges(perm) {
+			if err := c.policy.CheckGroupInput(group, permInput(perm, cidr)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return c.EC2API.AuthorizeSecurityGroupIngress(in)
+}
+
+func (c *guardClient) RevokeSecurityGroupIngress(in *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	group, err := c.group(in.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.policy.CheckGroup(group); err != nil {
+		return nil, err
+	}
+	return c.EC2API.RevokeSecurityGroupIngress(in)
+}
+
+// group with id, described if not seen before
+func (c *guardClient) group(id *string) (*ec2.SecurityGroup, error) {
+	c.mu.Lock()
+	group := c.groups[aws.StringValue(id)]
+	c.mu.Unlock()
+	if group != nil {
+		return group, nil
+	}
+
+	out, err := c.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("group-id"), Values: []*string{id}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.SecurityGroups) == 0 {
+		return nil, fmt.Errorf("security group %v not found", aws.StringValue(id))
+	}
+	return out.SecurityGroups[0], nil
+}
@@ -0,0 +1,100 @@
+package letmein
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"strings"
+	"testing"
+	"time"
+)
+
+func tag(key, value string) *ec2.Tag {
+	return &ec2.Tag{Key: aws.String(key), Value: aws.String(value)}
+}
+
+func TestPolicyCheckGroupInput(t *testing.T) {
+	policy := NewPolicy()
+	policy.OptIn = true
+
+	untagged := &ec2.SecurityGroup{GroupName: aws.String("core")}
+	enabled := &ec2.SecurityGroup{GroupName: aws.String("web"), Tags: []*ec2.Tag{tag(OptInTag, OptInEnabled)}}
+	limited := &ec2.SecurityGroup{GroupName: aws.String("db"), Tags: []*ec2.Tag{
+		tag(OptInTag, OptInEnabled), tag(PortsTag, "22, 5432-5433"), tag(ProtocolsTag, "tcp"),
+	}}
+	invalid := &ec2.SecurityGroup{GroupName: aws.String("bad"), Tags: []*ec2.Tag{tag(OptInTag, OptInEnabled), tag(PortsTag, "ssh")}}
+
+	for _, test := range []struct {
+		group *ec2.SecurityGroup
+		input Input
+		err   string
+	}{
+		{untagged, sshInput("198.51.100.1/32"), "not tagged let-me-in=enabled"},
+		{enabled, NewInput("udp", 53, "198.51.100.1/32"), ""},
+		{limited, sshInput("198.51.100.1/32"), ""},
+		{limited, NewInput("tcp", 5433, "198.51.100.1/32"), ""},
+		{limited, NewInput("tcp", 443, "198.51.100.1/32"), "only allows ports 22, 5432-5433"},
+		{limited, NewInput("udp", 22, "198.51.100.1/32"), "only allows tcp"},
+		{invalid, sshInput("198.51.100.1/32"), "invalid let-me-in:ports tag"},
+	} {
+		err := policy.CheckGroupInput(test.group, test.input)
+		if test.err == "" && err != nil || test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%v %v: expected error %q, got %v", *test.group.GroupName, inputKey(test.input), test.err, err)
+		}
+	}
+
+	// without opt in, only port and protocol tags apply
+	policy.OptIn = false
+	if err := policy.CheckGroup(untagged); err != nil {
+		t.Errorf("expected untagged group allowed, got %v", err)
+	}
+	if err := policy.CheckGroupInput(limited, NewInput("tcp", 443, "198.51.100.1/32")); err == nil {
+		t.Errorf("expected port tag to apply without opt in")
+	}
+}
+
+func TestMaxDuration(t *testing.T) {
+	for _, test := range []struct {
+		tags []*ec2.Tag
+		d    time.Duration
+		err  bool
+	}{
+		{nil, 0, false},
+		{[]*ec2.Tag{tag(MaxDurationTag, "90m")}, 90 * time.Minute, false},
+		{[]*ec2.Tag{tag(MaxDurationTag, "forever")}, 0, true},
+		{[]*ec2.Tag{tag(MaxDurationTag, "-1h")}, 0, true},
+	} {
+		d, err := MaxDuration(&ec2.SecurityGroup{GroupName: aws.String("web"), Tags: test.tags})
+		if d != test.d || (err != nil) != test.err {
+			t.Errorf("%v: expected %v, error %v; got %v, %v", test.tags, test.d, test.err, d, err)
+		}
+	}
+}
+
+func TestGuardClient(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("core", "web")
+	f.groups[1].Tags = []*ec2.Tag{tag(OptInTag, OptInEnabled)}
+	f.add("sg-0", "tcp", 22, "198.51.100.1/32")
+
+	policy := NewPolicy()
+	policy.OptIn = true
+	client := NewGuardClient(f, policy)
+
+	// groups known only by id, as from the ledger, are looked up
+	core := &ec2.SecurityGroup{GroupId: aws.String("sg-0")}
+	web := &ec2.SecurityGroup{GroupId: aws.String("sg-1")}
+
+	if err := AuthorizeGroup(ctx, client, core, sshInput("198.51.100.2/32")); err == nil || f.has("sg-0", "tcp", 22, "198.51.100.2/32") {
+		t.Errorf("expected authorize refused for group not opted in, got %v", err)
+	}
+	if err := RevokeGroup(ctx, client, core, sshInput("198.51.100.1/32")); err == nil || !f.has("sg-0", "tcp", 22, "198.51.100.1/32") {
+		t.Errorf("expected revoke refused for group not opted in, got %v", err)
+	}
+	if err := AuthorizeGroup(ctx, client, web, sshInput("198.51.100.2/32")); err != nil || !f.has("sg-1", "tcp", 22, "198.51.100.2/32") {
+		t.Errorf("expected authorize allowed for group opted in, got %v", err)
+	}
+	if err := RevokeGroup(ctx, client, web, sshInput("198.51.100.2/32")); err != nil {
+		t.Errorf("expected revoke allowed for group opted in, got %v", err)
+	}
+}
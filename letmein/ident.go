@@ -0,0 +1,190 @@
+package letmein
+
+import (
+	"context"
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// default timeout for each ident source
+const DefaultIdentTimeout = 5 * time.Second
+
+// Detector finds my public ip by asking several ident sources at once.
+type Detector struct {
+	Sources []string      // urls of ident services
+	Quorum  int           // number of sources that must answer and agree, default a majority
+	Timeout time.Duration // for each source, default DefaultIdentTimeout
+
+	// if set, and running on EC2 with a public address, use that instead
+	Metadata *ec2metadata.EC2Metadata
+
+	// detect IPv6 address instead of IPv4; answers of the other family are errors
+	IPv6 bool
+}
+
+// answer from a single ident source
+type identResult struct {
+	source string
+	ip     net.IP
+	err    error
+}
+
+// Detect queries all sources concurrently and returns the address they
+// agree on. It is an error if sources report different addresses, or
+// fewer than Quorum of them answer. If Metadata is set and reports a
+// public address for this instance, that is returned instead.
+func (d *Detector) Detect(ctx context.Context) (net.IP, error) {
+	if d.Metadata == nil {
+		return d.detectIdent(ctx)
+	}
+
+	// ask instance metadata while ident sources are queried, and stop
+	// waiting for them as soon as metadata gives us an answer
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	metadata := make(chan net.IP, 1)
+	go func() {
+		lookup := MetadataIp
+		if d.IPv6 {
+			lookup = MetadataIp6
+		}
+
+		ip := lookup(d.Metadata)
+		if ip != nil {
+			cancel()
+		}
+		metadata <- ip
+	}()
+
+	ip, err := d.detectIdent(ctx)
+	if mip := <-metadata; mip != nil {
+		return mip, nil
+	}
+	return ip, err
+}
+
+// query ident sources for quorum
+func (d *Detector) detectIdent(ctx context.Context) (net.IP, error) {
+	if len(d.Sources) == 0 {
+		return nil, fmt.Errorf("no ident sources given")
+	}
+
+	quorum := d.Quorum
+	if quorum <= 0 {
+		quorum = len(d.Sources)/2 + 1
+	}
+	if quorum > len(d.Sources) {
+		return nil, fmt.Errorf("ident quorum of %d is more than the %d sources given", quorum, len(d.Sources))
+	}
+
+	timeout := d.Timeout
+	if timeout <= 0 {
+		timeout = DefaultIdentTimeout
+	}
+
+	results := make(chan identResult, len(d.Sources))
+	for _, source := range d.Sources {
+		go func(source string) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			ip, err := GetMyIp(ctx, source)
+			if err == nil && (ip.To4() == nil) != d.IPv6 {
+				ip, err = nil, fmt.Errorf("%v: %v is not the address family wanted", source, ip)
+			}
+			results <- identResult{source, ip, err}
+		}(source)
+	}
+
+	// collect sources reporting each address
+	votes := map[string][]string{}
+	failures := []string{}
+	for range d.Sources {
+		r := <-results
+		if r.err != nil {
+			failures = append(failures, r.err.Error())
+			continue
+		}
+		votes[r.ip.String()] = append(votes[r.ip.String()], r.source)
+	}
+
+	if len(votes) > 1 {
+		answers := []string{}
+		for ip, sources := range votes {
+			sort.Strings(sources)
+			answers = append(answers, fmt.Sprintf("%v (%v)", ip, strings.Join(sources, ", ")))
+		}
+		sort.Strings(answers)
+		return nil, fmt.Errorf("ident sources disagree on public ip: %v", strings.Join(answers, "; "))
+	}
+
+	for ip, sources := range votes {
+		if len(sources) >= quorum {
+			return net.ParseIP(ip), nil
+		}
+	}
+
+	sort.Strings(failures)
+	return nil, fmt.Errorf("only %d of %d ident sources answered, need %d: %v",
+		len(d.Sources)-len(failures), len(d.Sources), quorum, strings.Join(failures, "; "))
+}
+
+// GetMyIp returns my external-facing ip as reported by a single ident
+// source: an http(s) url, stun:host[:port] for a STUN server, or
+// dns://server/name for a DNS server answering "what is my ip" queries.
+func GetMyIp(ctx context.Context, source string) (net.IP, error) {
+	switch {
+	case strings.HasPrefix(source, "stun:"):
+		return getStunIp(ctx, source)
+	case strings.HasPrefix(source, "dns:"):
+		return getDnsIp(ctx, source)
+	default:
+		return getHttpIp(ctx, source)
+	}
+}
+
+// ask ident service that returns our address as plain text
+func getHttpIp(ctx context.Context, url string) (net.IP, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v: %v", url, resp.Status)
+	}
+
+	// an address is short, anything longer is probably a captive portal page
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", url, err)
+	}
+
+	return parseIp(url, string(body))
+}
+
+// parse address from an ident answer
+func parseIp(source, answer string) (net.IP, error) {
+	s := strings.TrimSpace(answer)
+	ip := net.ParseIP(s)
+	if ip == nil {
+		if len(s) > 40 {
+			s = s[:40] + "..."
+		}
+		return nil, fmt.Errorf("%v: not an ip address: %q", source, s)
+	}
+	return ip, nil
+}
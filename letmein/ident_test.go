@@ -0,0 +1,124 @@
+package letmein
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ident server returning given status and body
+func newIdentServer(status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+}
+
+func TestGetMyIp(t *testing.T) {
+	for _, body := range []string{"203.0.113.7", "203.0.113.7\n", "  203.0.113.7\r\n"} {
+		ts := newIdentServer(200, body)
+		ip, err := GetMyIp(context.Background(), ts.URL)
+		ts.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ip.String() != "203.0.113.7" {
+			t.Errorf("body %q: expected 203.0.113.7, got %v", body, ip)
+		}
+	}
+}
+
+func TestGetMyIpErrors(t *testing.T) {
+	for _, test := range []struct {
+		status int
+		body   string
+		err    string
+	}{
+		{200, "<html><body>Please log in to the hotel wifi</body></html>", "not an ip address"},
+		{200, "", "not an ip address"},
+		{200, "203.0.113.7/32", "not an ip address"},
+		{500, "203.0.113.7", "500"},
+	} {
+		ts := newIdentServer(test.status, test.body)
+		_, err := GetMyIp(context.Background(), ts.URL)
+		ts.Close()
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%d %q: expected error containing %q, got %v", test.status, test.body, test.err, err)
+		}
+	}
+
+	ts := newIdentServer(200, "")
+	ts.Close()
+	if _, err := GetMyIp(context.Background(), ts.URL); err == nil {
+		t.Error("expected error for unreachable ident service")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	a := newIdentServer(200, "203.0.113.7\n")
+	defer a.Close()
+	b := newIdentServer(200, "203.0.113.7")
+	defer b.Close()
+	other := newIdentServer(200, "198.51.100.1")
+	defer other.Close()
+	v6 := newIdentServer(200, "2001:db8::1")
+	defer v6.Close()
+	portal := newIdentServer(200, "<html>")
+	defer portal.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		sources []string
+		quorum  int
+		err     string
+	}{
+		{[]string{a.URL}, 0, ""},
+		{[]string{a.URL, b.URL}, 0, ""},
+		{[]string{a.URL, b.URL, portal.URL}, 0, ""},
+		{[]string{a.URL, b.URL, portal.URL}, 3, "only 2 of 3"},
+		{[]string{a.URL, slow.URL, portal.URL}, 0, "only 1 of 3"},
+		{[]string{a.URL, b.URL, other.URL}, 1, "disagree"},
+		{[]string{a.URL}, 2, "more than"},
+		{nil, 0, "no ident sources"},
+		{[]string{a.URL, v6.URL}, 2, "not the address family"},
+	} {
+		d := &Detector{Sources: test.sources, Quorum: test.quorum, Timeout: 100 * time.Millisecond}
+		ip, err := d.Detect(ctx)
+
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%v: %v", test.sources, err)
+			} else if ip.String() != "203.0.113.7" {
+				t.Errorf("%v: expected 203.0.113.7, got %v", test.sources, ip)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%v quorum %d: expected error containing %q, got %v", test.sources, test.quorum, test.err, err)
+		}
+	}
+}
+
+func TestDetectIPv6(t *testing.T) {
+	v4 := newIdentServer(200, "203.0.113.7")
+	defer v4.Close()
+	v6 := newIdentServer(200, "2001:db8::1\n")
+	defer v6.Close()
+
+	d := &Detector{Sources: []string{v6.URL}, IPv6: true}
+	if ip, err := d.Detect(context.Background()); err != nil || ip.String() != "2001:db8::1" {
+		t.Errorf("expected 2001:db8::1, got %v %v", ip, err)
+	}
+
+	d = &Detector{Sources: []string{v4.URL}, IPv6: true}
+	if _, err := d.Detect(context.Background()); err == nil || !strings.Contains(err.Error(), "not the address family") {
+		t.Errorf("expected address family error, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package letmein
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// IdentServer is an http.Handler that tells callers their address, as
+// plain text, or as JSON if requested with ?format=json or an Accept
+// header of application/json.
+type IdentServer struct {
+	// X-Forwarded-For is only believed from these proxies; empty means
+	// the header is ignored
+	TrustedProxies []*net.IPNet
+}
+
+func (s *IdentServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ip := s.ClientIp(r)
+	if ip == nil {
+		http.Error(w, "cannot determine client address", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.URL.Query().Get("format") == "json" || strings.Contains(r.Header.Get("Accept"), "application/json") {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"ip": ip.String()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	fmt.Fprintln(w, ip)
+}
+
+// ClientIp returns the address of the caller. If the request came from
+// a trusted proxy, X-Forwarded-For is followed back to the first address
+// that is not a trusted proxy.
+func (s *IdentServer) ClientIp(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil
+	}
+
+	// walk forwarded addresses from nearest hop outwards
+	forwarded := []string{}
+	for _, header := range r.Header["X-Forwarded-For"] {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0 && s.trusted(ip); i-- {
+		hop := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if hop == nil {
+			break // garbage in header, believe the last good address
+		}
+		ip = hop
+	}
+
+	return ip
+}
+
+// true if ip is a trusted proxy
+func (s *IdentServer) trusted(ip net.IP) bool {
+	for _, n := range s.TrustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package letmein
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func mustCidrs(t *testing.T, cidrs ...string) []*net.IPNet {
+	nets := []*net.IPNet{}
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
+
+func TestIdentServerClientIp(t *testing.T) {
+	trusting := &IdentServer{TrustedProxies: mustCidrs(t, "10.0.0.0/8")}
+
+	for _, test := range []struct {
+		server    *IdentServer
+		remote    string
+		forwarded string
+		ip        string
+	}{
+		{&IdentServer{}, "203.0.113.7:1234", "", "203.0.113.7"},
+		{&IdentServer{}, "10.0.0.1:1234", "203.0.113.7", "10.0.0.1"},
+		{trusting, "10.0.0.1:1234", "203.0.113.7", "203.0.113.7"},
+		{trusting, "198.51.100.1:1234", "203.0.113.7", "198.51.100.1"},
+		{trusting, "10.0.0.1:1234", "1.1.1.1, 203.0.113.7, 10.0.0.2", "203.0.113.7"},
+		{trusting, "10.0.0.1:1234", "garbage", "10.0.0.1"},
+		{trusting, "[2001:db8::1]:1234", "", "2001:db8::1"},
+	} {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = test.remote
+		if test.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", test.forwarded)
+		}
+
+		if ip := test.server.ClientIp(r); ip.String() != test.ip {
+			t.Errorf("%v via %q: expected %v, got %v", test.remote, test.forwarded, test.ip, ip)
+		}
+	}
+}
+
+func TestIdentServerFormats(t *testing.T) {
+	s := &IdentServer{}
+
+	for _, test := range []struct {
+		url    string
+		accept string
+		body   string
+	}{
+		{"/", "", "203.0.113.7\n"},
+		{"/?format=json", "", `{"ip":"203.0.113.7"}` + "\n"},
+		{"/", "application/json", `{"ip":"203.0.113.7"}` + "\n"},
+	} {
+		r := httptest.NewRequest("GET", test.url, nil)
+		r.RemoteAddr = "203.0.113.7:1234"
+		r.Header.Set("Accept", test.accept)
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, r)
+
+		if w.Code != http.StatusOK || w.Body.String() != test.body {
+			t.Errorf("%v %q: got %d %q", test.url, test.accept, w.Code, w.Body.String())
+		}
+	}
+}
+
+// our own ident client must understand our own server
+func TestIdentServerWithGetMyIp(t *testing.T) {
+	ts := httptest.NewServer(&IdentServer{})
+	defer ts.Close()
+
+	ip, err := GetMyIp(context.Background(), ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(ip.String(), "127.") {
+		t.Errorf("expected loopback address, got %v", ip)
+	}
+}
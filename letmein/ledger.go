@@ -0,0 +1,246 @@
+package letmein
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// actions recorded in the ledger
+const (
+	ActionAuthorize = "authorize"
+	ActionRevoke    = "revoke"
+)
+
+// LedgerEntry is a single rule authorized or revoked, as a line of JSON in
+// the ledger.
+type LedgerEntry struct {
+	Time        time.Time `json:"time"`
+	Run         string    `json:"run"`  // identifies the invocation that made the change
+	Pid         int       `json:"pid"`  // of the invocation
+	Mode        string    `json:"mode"` // command, e.g. grant, exec or undo
+	Action      string    `json:"action"`
+	Region      string    `json:"region,omitempty"`
+	GroupId     string    `json:"group_id"`
+	Protocol    string    `json:"protocol"`
+	FromPort    int64     `json:"from_port"`
+	ToPort      int64     `json:"to_port"`
+	Cidr        string    `json:"cidr"`
+	Description string    `json:"description,omitempty"`
+	Undoes      string    `json:"undoes,omitempty"` // run reversed by an undo
+}
+
+// Input returns the permission of entry.
+func (e LedgerEntry) Input() Input {
+	input := Input{
+		GroupId:    aws.String(e.GroupId),
+		IpProtocol: aws.String(e.Protocol),
+		FromPort:   aws.Int64(e.FromPort),
+		ToPort:     aws.Int64(e.ToPort),
+	}
+	input.setCidr(e.Cidr)
+	if e.Description != "" {
+		input.Description = aws.String(e.Description)
+	}
+	return input
+}
+
+// Ledger appends an entry to a local JSON lines file for every change made
+// by the current invocation, so grants left behind by a crash can be found
+// and operations undone.
+type Ledger struct {
+	Path   string
+	Run    string
+	Pid    int
+	Mode   string
+	Undoes string      // set while undoing a run
+	Warn   func(error) // called if an entry cannot be written, if set
+
+	mu sync.Mutex
+}
+
+// NewLedger returns a ledger writing to path for this process, with a run
+// id made from the time and pid.
+func NewLedger(path string) *Ledger {
+	pid := os.Getpid()
+	return &Ledger{
+		Path: path,
+		Run:  fmt.Sprintf("%v-%d", time.Now().UTC().Format("20060102T150405.000Z"), pid),
+		Pid:  pid,
+	}
+}
+
+// Record appends an entry for action on input in region.
+func (l *Ledger) Record(action, region string, input Input) error {
+	entry := LedgerEntry{
+		Time:        time.Now().UTC(),
+		Run:         l.Run,
+		Pid:         l.Pid,
+		Mode:        l.Mode,
+		Action:      action,
+		Region:      region,
+		GroupId:     aws.StringValue(input.GroupId),
+		Protocol:    aws.StringValue(input.IpProtocol),
+		FromPort:    aws.Int64Value(input.FromPort),
+		ToPort:      aws.Int64Value(input.ToPort),
+		Cidr:        input.Cidr(),
+		Description: aws.StringValue(input.Description),
+		Undoes:      l.Undoes,
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.Path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadLedger returns all entries in the ledger at path, oldest first. A
+// missing ledger has no entries.
+func ReadLedger(path string) ([]LedgerEntry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries := []LedgerEntry{}
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry LedgerEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("%v:%d: %v", path, n, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// Outstanding returns the entries for rules authorized and not revoked
+// since, according to entries.
+func Outstanding(entries []LedgerEntry) []LedgerEntry {
+	index := map[string]int{}
+	granted := []*LedgerEntry{}
+	for i := range entries {
+		e := &entries[i]
+		key := e.Region + "/" + e.GroupId + "/" + inputKey(e.Input())
+		switch e.Action {
+		case ActionAuthorize:
+			if j, ok := index[key]; ok {
+				granted[j] = e
+			} else {
+				index[key] = len(granted)
+				granted = append(granted, e)
+			}
+		case ActionRevoke:
+			if j, ok := index[key]; ok {
+				granted[j] = nil
+				delete(index, key)
+			}
+		}
+	}
+
+	outstanding := []LedgerEntry{}
+	for _, e := range granted {
+		if e != nil {
+			outstanding = append(outstanding, *e)
+		}
+	}
+	return outstanding
+}
+
+// LastRun returns the entries of the latest run that made changes and has
+// not been undone, skipping runs that were themselves undos.
+func LastRun(entries []LedgerEntry) []LedgerEntry {
+	undone := map[string]bool{}
+	for _, e := range entries {
+		if e.Undoes != "" {
+			undone[e.Undoes] = true
+		}
+	}
+
+	run := ""
+	for i := len(entries) - 1; i >= 0; i-- {
+		if e := entries[i]; e.Undoes == "" && !undone[e.Run] {
+			run = e.Run
+			break
+		}
+	}
+
+	last := []LedgerEntry{}
+	for _, e := range entries {
+		if run != "" && e.Run == run && e.Undoes == "" {
+			last = append(last, e)
+		}
+	}
+	return last
+}
+
+// NewLedgerClient wraps client so that every ingress rule it authorizes or
+// revokes in region is recorded in ledger.
+func NewLedgerClient(client ec2iface.EC2API, ledger *Ledger, region string) ec2iface.EC2API {
+	return &ledgerClient{client, ledger, region}
+}
+
+type ledgerClient struct {
+	ec2iface.EC2API
+	ledger *Ledger
+	region string
+}
+
+func (c *ledgerClient) AuthorizeSecurityGroupIngress(in *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	out, err := c.EC2API.AuthorizeSecurityGroupIngress(in)
+	if err == nil {
+		c.record(ActionAuthorize, in.GroupId, in.IpPermissions)
+	}
+	return out, err
+}
+
+func (c *ledgerClient) RevokeSecurityGroupIngress(in *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	out, err := c.EC2API.RevokeSecurityGroupIngress(in)
+	if err == nil {
+		c.record(ActionRevoke, in.GroupId, in.IpPermissions)
+	}
+	return out, err
+}
+
+// record each range of perms; the change is made, so failures only warn
+func (c *ledgerClient) record(action string, groupId *string, perms []*ec2.IpPermission) {
+	for _, perm := range perms {
+		for _, cidr := range Ranges(perm) {
+			input := permInput(perm, cidr)
+			input.GroupId = groupId
+			if err := c.ledger.Record(action, c.region, input); err != nil && c.ledger.Warn != nil {
+				c.ledger.Warn(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package letmein
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLedgerClient(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "let-me-in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state", "ledger.jsonl")
+	if entries, err := ReadLedger(path); err != nil || len(entries) != 0 {
+		t.Errorf("expected empty ledger, got %v %v", entries, err)
+	}
+
+	f := newFakeEC2("web")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	grant := NewLedger(path)
+	grant.Mode = "grant"
+	client := NewLedgerClient(f, grant, "us-east-1")
+	AuthorizeGroup(ctx, client, groups[0], sshInput("198.51.100.1/32"))
+	AuthorizeGroup(ctx, client, groups[0], sshInput("198.51.100.1/32")) // duplicate, not recorded
+	AuthorizeGroup(ctx, client, groups[0], sshInput("2001:db8::1/128"))
+
+	revoke := NewLedger(path)
+	revoke.Run, revoke.Mode = "second", "revoke"
+	RevokeGroup(ctx, NewLedgerClient(f, revoke, "us-east-1"), groups[0], sshInput("198.51.100.1/32"))
+
+	entries, err := ReadLedger(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Action != ActionAuthorize || entries[0].GroupId != "sg-0" || entries[0].Pid != os.Getpid() ||
+		entries[1].Cidr != "2001:db8::1/128" || entries[2].Action != ActionRevoke || entries[2].Mode != "revoke" {
+		t.Fatalf("unexpected entries: %v", entries)
+	}
+
+	if outstanding := Outstanding(entries); len(outstanding) != 1 || outstanding[0].Cidr != "2001:db8::1/128" {
+		t.Errorf("expected only IPv6 rule outstanding, got %v", outstanding)
+	}
+
+	if last := LastRun(entries); len(last) != 1 || last[0].Run != "second" {
+		t.Errorf("expected revoke as last run, got %v", last)
+	}
+
+	// once undone, the run before is the last
+	undo := NewLedger(path)
+	undo.Run, undo.Mode, undo.Undoes = "third", "undo", "second"
+	AuthorizeGroup(ctx, NewLedgerClient(f, undo, "us-east-1"), groups[0], entries[2].Input())
+
+	entries, _ = ReadLedger(path)
+	if last := LastRun(entries); len(last) != 2 || last[0].Run != grant.Run {
+		t.Errorf("expected grant as last run, got %v", last)
+	}
+	if outstanding := Outstanding(entries); len(outstanding) != 2 {
+		t.Errorf("expected 2 outstanding rules after undo, got %v", outstanding)
+	}
+}
+
+func TestLedgerRecover(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "let-me-in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ledger.jsonl")
+
+	f := newFakeEC2("web")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	grant := NewLedger(path)
+	grant.Run, grant.Mode = "first", "grant"
+	AuthorizeGroup(ctx, NewLedgerClient(f, grant, "us-east-1"), groups[0], sshInput("198.51.100.1/32"))
+
+	crashed := NewLedger(path)
+	crashed.Run, crashed.Mode = "crashed", "exec"
+	AuthorizeGroup(ctx, NewLedgerClient(f, crashed, "us-east-1"), groups[0], sshInput("198.51.100.2/32"))
+
+	// a later run revokes what the crashed one left, as undoing it
+	recovery := NewLedger(path)
+	recovery.Run, recovery.Mode, recovery.Undoes = "recover", "exec", "crashed"
+	RevokeGroup(ctx, NewLedgerClient(f, recovery, "us-east-1"), groups[0], sshInput("198.51.100.2/32"))
+
+	entries, _ := ReadLedger(path)
+	if outstanding := Outstanding(entries); len(outstanding) != 1 || outstanding[0].Run != "first" {
+		t.Errorf("expected only first grant outstanding, got %v", outstanding)
+	}
+
+	// undo reverses the grant before the crash, and re-opens nothing
+	last := LastRun(entries)
+	if len(last) != 1 || last[0].Run != "first" {
+		t.Fatalf("expected grant before crash as last run, got %v", last)
+	}
+	undo := NewLedger(path)
+	undo.Run, undo.Mode, undo.Undoes = "undo", "undo", last[0].Run
+	for _, e := range last {
+		RevokeGroup(ctx, NewLedgerClient(f, undo, "us-east-1"), groups[0], e.Input())
+	}
+	if f.has("sg-0", "tcp", 22, "198.51.100.1/32") || f.has("sg-0", "tcp", 22, "198.51.100.2/32") {
+		t.Errorf("expected no rules after undo, got %v", f.groups[0].IpPermissions)
+	}
+
+	entries, _ = ReadLedger(path)
+	if last := LastRun(entries); len(last) != 0 {
+		t.Errorf("expected nothing left to undo, got %v", last)
+	}
+}
@@ -0,0 +1,87 @@
+package letmein
+
+import (
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/endpoints"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// default timeout for instance metadata requests; off EC2 the link-local
+// endpoint usually does not answer at all, so keep this short
+const DefaultMetadataTimeout = time.Second
+
+// how long to wait to connect to instance metadata; on EC2 it is local and
+// answers at once, elsewhere packets to it are usually dropped, so give up
+// quickly rather than slow down every grant
+var metadataDialTimeout = 200 * time.Millisecond
+
+// NewMetadataClient returns a client for the EC2 instance metadata service
+// at endpoint, or the default link-local address if empty. Requests are
+// not retried, so detection fails fast when not running on EC2.
+func NewMetadataClient(endpoint string, timeout time.Duration) *ec2metadata.EC2Metadata {
+	if timeout <= 0 {
+		timeout = DefaultMetadataTimeout
+	}
+
+	if endpoint == "" {
+		e, _ := endpoints.DefaultResolver().EndpointFor(endpoints.Ec2metadataServiceID, "")
+		endpoint = e.URL
+	}
+
+	dialer := &net.Dialer{Timeout: metadataDialTimeout}
+	config := defaults.Config().
+		WithHTTPClient(&http.Client{Timeout: timeout, Transport: &http.Transport{Dial: dialer.Dial}}).
+		WithMaxRetries(0)
+
+	return ec2metadata.NewClient(*config, defaults.Handlers(), endpoint, "")
+}
+
+// MetadataIp returns the public ipv4 address of the EC2 instance we are
+// running on, or nil if not on EC2 or the instance has no public address.
+func MetadataIp(client *ec2metadata.EC2Metadata) net.IP {
+	if !client.Available() {
+		return nil
+	}
+
+	// 404 when instance has no public address
+	s, err := client.GetMetadata("public-ipv4")
+	if err != nil {
+		return nil
+	}
+
+	ip, err := parseIp("instance metadata", s)
+	if err != nil {
+		return nil
+	}
+	return ip
+}
+
+// MetadataIp6 returns the first IPv6 address of the primary network
+// interface of the EC2 instance we are running on, or nil if not on EC2 or
+// the instance has no IPv6 address. EC2 IPv6 addresses are all public.
+func MetadataIp6(client *ec2metadata.EC2Metadata) net.IP {
+	if !client.Available() {
+		return nil
+	}
+
+	mac, err := client.GetMetadata("mac")
+	if err != nil {
+		return nil
+	}
+
+	// one address per line, 404 if none
+	s, err := client.GetMetadata("network/interfaces/macs/" + strings.TrimSpace(mac) + "/ipv6s")
+	if err != nil {
+		return nil
+	}
+
+	ip, err := parseIp("instance metadata", strings.SplitN(strings.TrimSpace(s), "\n", 2)[0])
+	if err != nil || ip.To4() != nil {
+		return nil
+	}
+	return ip
+}
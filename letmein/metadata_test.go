@@ -0,0 +1,114 @@
+package letmein
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// instance metadata stand-in serving given paths under /latest/meta-data/
+func newMetadataServer(data map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/latest/meta-data/")
+		value, ok := data[path]
+		if !ok || path == r.URL.Path {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, value)
+	}))
+}
+
+func TestMetadataIp(t *testing.T) {
+	public := newMetadataServer(map[string]string{"instance-id": "i-0123456789abcdef0", "public-ipv4": "203.0.113.9"})
+	defer public.Close()
+	private := newMetadataServer(map[string]string{"instance-id": "i-0123456789abcdef0"})
+	defer private.Close()
+	down := newMetadataServer(nil)
+	down.Close()
+
+	if ip := MetadataIp(NewMetadataClient(public.URL+"/latest", 0)); ip.String() != "203.0.113.9" {
+		t.Errorf("expected 203.0.113.9, got %v", ip)
+	}
+	if ip := MetadataIp(NewMetadataClient(private.URL+"/latest", 0)); ip != nil {
+		t.Errorf("expected nil for instance without public ip, got %v", ip)
+	}
+	if ip := MetadataIp(NewMetadataClient(down.URL+"/latest", 0)); ip != nil {
+		t.Errorf("expected nil when not on EC2, got %v", ip)
+	}
+}
+
+func TestMetadataUnreachable(t *testing.T) {
+	// non-routable address, where connecting hangs as off EC2
+	start := time.Now()
+	if ip := MetadataIp(NewMetadataClient("http://10.255.255.1/latest", 0)); ip != nil {
+		t.Errorf("expected nil when not on EC2, got %v", ip)
+	}
+	if d := time.Since(start); d >= DefaultMetadataTimeout {
+		t.Errorf("expected to give up before the %v timeout, took %v", DefaultMetadataTimeout, d)
+	}
+}
+
+func TestMetadataIp6(t *testing.T) {
+	dual := newMetadataServer(map[string]string{
+		"instance-id": "i-0123456789abcdef0",
+		"mac":         "0e:00:00:00:00:01",
+		"network/interfaces/macs/0e:00:00:00:00:01/ipv6s": "2001:db8::9\n2001:db8::10",
+	})
+	defer dual.Close()
+	v4only := newMetadataServer(map[string]string{"instance-id": "i-0123456789abcdef0", "mac": "0e:00:00:00:00:01"})
+	defer v4only.Close()
+
+	if ip := MetadataIp6(NewMetadataClient(dual.URL+"/latest", 0)); ip.String() != "2001:db8::9" {
+		t.Errorf("expected 2001:db8::9, got %v", ip)
+	}
+	if ip := MetadataIp6(NewMetadataClient(v4only.URL+"/latest", 0)); ip != nil {
+		t.Errorf("expected nil for instance without IPv6, got %v", ip)
+	}
+}
+
+func TestDetectMetadata(t *testing.T) {
+	public := newMetadataServer(map[string]string{"instance-id": "i-0123456789abcdef0", "public-ipv4": "203.0.113.9"})
+	defer public.Close()
+	private := newMetadataServer(map[string]string{"instance-id": "i-0123456789abcdef0"})
+	defer private.Close()
+	ident := newIdentServer(200, "203.0.113.7")
+	defer ident.Close()
+	blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer blocked.Close()
+
+	for _, test := range []struct {
+		metadata string
+		source   string
+		expect   string
+	}{
+		{public.URL, blocked.URL, "203.0.113.9"},
+		{public.URL, ident.URL, "203.0.113.9"},
+		{private.URL, ident.URL, "203.0.113.7"},
+	} {
+		d := &Detector{
+			Sources:  []string{test.source},
+			Timeout:  5 * time.Second,
+			Metadata: NewMetadataClient(test.metadata+"/latest", 0),
+		}
+
+		start := time.Now()
+		ip, err := d.Detect(context.Background())
+		if err != nil {
+			t.Errorf("%v: %v", test.metadata, err)
+		} else if ip.String() != test.expect {
+			t.Errorf("%v: expected %v, got %v", test.metadata, test.expect, ip)
+		}
+
+		// blocked ident source should not hold us up
+		if time.Since(start) > 2*time.Second {
+			t.Errorf("%v: took %v", test.metadata, time.Since(start))
+		}
+	}
+}
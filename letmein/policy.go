@@ -0,0 +1,150 @@
+package letmein
+
+import (
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"net"
+	"regexp"
+)
+
+// RequireReasonTag marks a security group as needing a reason or ticket
+// for access, when set to true.
+const RequireReasonTag = "let-me-in:require-reason"
+
+// default shortest prefix lengths allowed, i.e. nothing broader than an
+// IPv4 /24, or an IPv6 /64 which is a single subnet
+const (
+	DefaultMinPrefix  = 24
+	DefaultMinPrefix6 = 64
+)
+
+// Policy refuses changes to rules with cidr blocks that are too broad, so
+// nobody opens a group to the world, or removes a baseline allow-list,
+// by accident.
+type Policy struct {
+	MinPrefix   int           // shortest IPv4 prefix length allowed, e.g. 24 refuses a /16
+	PortPrefix  map[int64]int // stricter minimum for sensitive ports, e.g. 22: 32
+	MinPrefix6  int           // as MinPrefix, for IPv6
+	PortPrefix6 map[int64]int // as PortPrefix, for IPv6
+	Protected   []string      // cidr blocks whose rules are never removed
+	OptIn       bool          // only change groups tagged OptInTag=OptInEnabled
+
+	RequireReason []string       // names of groups needing a reason or ticket for access
+	ReasonPattern *regexp.Regexp // reasons must match, if set
+	TicketPattern *regexp.Regexp // tickets must match, if set
+}
+
+// NewPolicy returns a policy with the default limits and no sensitive ports.
+func NewPolicy() *Policy {
+	return &Policy{
+		MinPrefix:   DefaultMinPrefix,
+		PortPrefix:  map[int64]int{},
+		MinPrefix6:  DefaultMinPrefix6,
+		PortPrefix6: map[int64]int{},
+	}
+}
+
+// Check returns an error if the cidr of input is broader than allowed for
+// its protocol and ports.
+func (p *Policy) Check(input Input) error {
+	return p.check(aws.StringValue(input.IpProtocol), aws.Int64Value(input.FromPort), aws.Int64Value(input.ToPort), input.Cidr())
+}
+
+// Allows returns true if policy allows changing cidr block of permission.
+func (p *Policy) Allows(perm *ec2.IpPermission, cidr string) bool {
+	return p.check(aws.StringValue(perm.IpProtocol), aws.Int64Value(perm.FromPort), aws.Int64Value(perm.ToPort), cidr) == nil
+}
+
+// Protects returns true if rules for cidr must never be removed.
+func (p *Policy) Protects(cidr string) bool {
+	for _, c := range p.Protected {
+		if c == cidr {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckReason returns an error if group requires a reason or ticket for
+// access and neither is given, either by policy or RequireReasonTag, or if
+// a reason or ticket given does not match its pattern.
+func (p *Policy) CheckReason(group *ec2.SecurityGroup, reason, ticket string) error {
+	if reason != "" && p.ReasonPattern != nil && !p.ReasonPattern.MatchString(reason) {
+		return fmt.Errorf("reason %q does not match %v required by policy", reason, p.ReasonPattern)
+	}
+	if ticket != "" && p.TicketPattern != nil && !p.TicketPattern.MatchString(ticket) {
+		return fmt.Errorf("ticket %q does not match %v required by policy", ticket, p.TicketPattern)
+	}
+
+	if reason == "" && ticket == "" && p.requiresReason(group) {
+		return fmt.Errorf("group %v requires a reason or ticket for access", aws.StringValue(group.GroupName))
+	}
+	return nil
+}
+
+// true if policy or a tag on group require a reason for access
+func (p *Policy) requiresReason(group *ec2.SecurityGroup) bool {
+	for _, name := range p.RequireReason {
+		if name == aws.StringValue(group.GroupName) || name == aws.StringValue(group.GroupId) {
+			return true
+		}
+	}
+	for _, tag := range group.Tags {
+		if aws.StringValue(tag.Key) == RequireReasonTag && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// Split returns copies of groups containing only the ip ranges the policy
+// allows changing, and copies containing only those it refuses.
+func (p *Policy) Split(groups []*ec2.SecurityGroup) (allowed, refused []*ec2.SecurityGroup) {
+	allowed = FilterGroups(groups, p.Allows)
+	refused = FilterGroups(groups, func(perm *ec2.IpPermission, cidr string) bool {
+		return !p.Allows(perm, cidr)
+	})
+	return allowed, refused
+}
+
+func (p *Policy) check(protocol string, from, to int64, cidr string) error {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("invalid cidr %q", cidr)
+	}
+
+	base, limits := p.MinPrefix, p.PortPrefix
+	if n.IP.To4() == nil {
+		base, limits = p.MinPrefix6, p.PortPrefix6
+	}
+
+	ones, _ := n.Mask.Size()
+	if min := minPrefix(base, limits, protocol, from, to); ones < min {
+		return fmt.Errorf("cidr %v is broader than the /%v allowed by policy for %v", cidr, min, portRange(protocol, from, to))
+	}
+	return nil
+}
+
+// prefix length required for protocol and port range, which is stricter if
+// the range covers a sensitive port
+func minPrefix(min int, limits map[int64]int, protocol string, from, to int64) int {
+	for port, prefix := range limits {
+		if prefix > min && (protocol == "-1" || (from <= port && port <= to)) {
+			min = prefix
+		}
+	}
+	return min
+}
+
+// describe ports for messages, e.g. tcp/22 or tcp/8000-8080
+func portRange(protocol string, from, to int64) string {
+	switch {
+	case protocol == "-1":
+		return "all traffic"
+	case from == to:
+		return fmt.Sprintf("%v/%v", protocol, from)
+	default:
+		return fmt.Sprintf("%v/%v-%v", protocol, from, to)
+	}
+}
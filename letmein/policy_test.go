@@ -0,0 +1,92 @@
+package letmein
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	p := NewPolicy()
+	p.PortPrefix[22] = 32
+	p.PortPrefix6[22] = 128
+
+	for _, test := range []struct {
+		protocol string
+		from, to int64
+		cidr     string
+		err      string
+	}{
+		{"tcp", 443, 443, "1.2.3.4/32", ""},
+		{"tcp", 443, 443, "1.2.3.0/24", ""},
+		{"tcp", 443, 443, "1.2.0.0/16", "broader than the /24"},
+		{"tcp", 443, 443, "0.0.0.0/0", "broader than the /24"},
+		{"tcp", 22, 22, "1.2.3.4/32", ""},
+		{"tcp", 22, 22, "1.2.3.0/24", "broader than the /32 allowed by policy for tcp/22"},
+		{"tcp", 20, 30, "1.2.3.0/24", "tcp/20-30"},
+		{"-1", 0, 0, "1.2.3.0/24", "all traffic"},
+		{"tcp", 443, 443, "2001:db8::/64", ""},
+		{"tcp", 443, 443, "2001:db8::/48", "broader than the /64"},
+		{"tcp", 22, 22, "2001:db8::1/128", ""},
+		{"tcp", 22, 22, "2001:db8::/64", "broader than the /128"},
+	} {
+		input := NewInput(test.protocol, test.from, test.cidr)
+		input.ToPort = aws.Int64(test.to)
+		err := p.Check(input)
+		if test.err == "" && err != nil {
+			t.Errorf("%v %v: %v", test.cidr, test.from, err)
+		}
+		if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%v %v: expected error containing %q, got %v", test.cidr, test.from, test.err, err)
+		}
+	}
+}
+
+func TestPolicySplit(t *testing.T) {
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "1.2.3.4/32")
+	f.add("sg-0", "tcp", 22, "10.0.0.0/8")
+	f.add("sg-0", "tcp", 443, "0.0.0.0/0")
+	groups, _ := GetGroups(context.Background(), f, []string{"web"}, "group-name")
+
+	allowed, refused := NewPolicy().Split(groups)
+	if n := len(allowed[0].IpPermissions); n != 1 || *allowed[0].IpPermissions[0].IpRanges[0].CidrIp != "1.2.3.4/32" {
+		t.Errorf("unexpected allowed rules: %v", allowed)
+	}
+	if n := len(refused[0].IpPermissions); n != 2 {
+		t.Errorf("expected 2 refused permissions, got %v", refused)
+	}
+}
+
+func TestPolicyCheckReason(t *testing.T) {
+	policy := NewPolicy()
+	policy.RequireReason = []string{"db"}
+	policy.TicketPattern = regexp.MustCompile(`^[A-Z]+-[0-9]+$`)
+
+	db := &ec2.SecurityGroup{GroupId: aws.String("sg-1"), GroupName: aws.String("db")}
+	web := &ec2.SecurityGroup{GroupId: aws.String("sg-2"), GroupName: aws.String("web")}
+	tagged := &ec2.SecurityGroup{GroupId: aws.String("sg-3"), GroupName: aws.String("billing"),
+		Tags: []*ec2.Tag{{Key: aws.String(RequireReasonTag), Value: aws.String("true")}}}
+
+	for _, test := range []struct {
+		group          *ec2.SecurityGroup
+		reason, ticket string
+		err            string
+	}{
+		{web, "", "", ""},
+		{db, "", "", "requires a reason"},
+		{tagged, "", "", "requires a reason"},
+		{db, "migration", "", ""},
+		{tagged, "", "OPS-12", ""},
+		{db, "", "ops12", "does not match"},
+		{web, "", "ops12", "does not match"},
+	} {
+		err := policy.CheckReason(test.group, test.reason, test.ticket)
+		if test.err == "" && err != nil || test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%v %q %q: expected error %q, got %v", *test.group.GroupName, test.reason, test.ticket, test.err, err)
+		}
+	}
+}
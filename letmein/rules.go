@@ -0,0 +1,195 @@
+package letmein
+
+import (
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"io/ioutil"
+)
+
+// RuleSet is the ingress rules that should exist in security groups, as
+// kept in a YAML file, e.g. in git.
+type RuleSet struct {
+	Groups []*GroupRules `yaml:"groups"`
+}
+
+// GroupRules lists the rules for a single security group, found by id, or
+// by name if no id is given.
+type GroupRules struct {
+	Id      string  `yaml:"id"`
+	Name    string  `yaml:"name"`
+	Ingress []*Rule `yaml:"ingress"`
+}
+
+// Rule allows a protocol and port range from a list of cidrs. Port may be
+// given instead of From and To for a single port; for icmp they are the
+// type and code. Protocol -1, or all, allows all traffic and takes no
+// ports.
+type Rule struct {
+	Protocol    string   `yaml:"protocol"`
+	Port        int64    `yaml:"port"`
+	From        int64    `yaml:"from"`
+	To          int64    `yaml:"to"`
+	Cidrs       []string `yaml:"cidrs"`
+	Description string   `yaml:"description"`
+}
+
+// LoadRuleSet reads and validates a rule set from a YAML file.
+func LoadRuleSet(path string) (*RuleSet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := ParseRuleSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+	return rules, nil
+}
+
+// ParseRuleSet parses a rule set from YAML, defaulting protocol to tcp and
+// normalizing cidrs.
+func ParseRuleSet(data []byte) (*RuleSet, error) {
+	rules := &RuleSet{}
+	if err := yaml.UnmarshalStrict(data, rules); err != nil {
+		return nil, err
+	}
+
+	for i, group := range rules.Groups {
+		if group.Id == "" && group.Name == "" {
+			return nil, fmt.Errorf("group %d has no id or name", i+1)
+		}
+		for _, rule := range group.Ingress {
+			if err := rule.normalize(); err != nil {
+				return nil, fmt.Errorf("group %v: %v", group, err)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// String returns the name of group, or its id.
+func (g *GroupRules) String() string {
+	if g.Name != "" {
+		return g.Name
+	}
+	return g.Id
+}
+
+// Inputs returns an input for each cidr of each rule for group.
+func (g *GroupRules) Inputs() []Input {
+	inputs := []Input{}
+	for _, rule := range g.Ingress {
+		for _, cidr := range rule.Cidrs {
+			input := Input{
+				IpProtocol: aws.String(rule.Protocol),
+				FromPort:   aws.Int64(rule.From),
+				ToPort:     aws.Int64(rule.To),
+			}
+			input.setCidr(cidr)
+			if rule.Description != "" {
+				input.Description = aws.String(rule.Description)
+			}
+			inputs = append(inputs, input)
+		}
+	}
+	return inputs
+}
+
+// check rule and fill in defaults
+func (r *Rule) normalize() error {
+	switch r.Protocol {
+	case "":
+		r.Protocol = "tcp"
+	case "all":
+		r.Protocol = "-1"
+	}
+	if r.Port != 0 {
+		if r.From != 0 || r.To != 0 {
+			return fmt.Errorf("rule for port %v also gives from and to", r.Port)
+		}
+		r.From, r.To = r.Port, r.Port
+	}
+
+	switch r.Protocol {
+	case "tcp", "udp":
+		if r.From == 0 && r.To == 0 {
+			return fmt.Errorf("rule for %v gives no port or range", r.Protocol)
+		}
+		if r.From < 0 || r.To > 65535 || r.From > r.To {
+			return fmt.Errorf("invalid port range %v-%v", r.From, r.To)
+		}
+	case "icmp":
+	case "-1":
+		// as EC2 reports all traffic rules
+		if r.From != 0 || r.To != 0 {
+			return fmt.Errorf("rule for all traffic gives ports %v-%v", r.From, r.To)
+		}
+		r.From, r.To = -1, -1
+	default:
+		return fmt.Errorf("unknown protocol %q, expected tcp, udp, icmp or -1", r.Protocol)
+	}
+
+	if len(r.Cidrs) == 0 {
+		return fmt.Errorf("rule for %v %v-%v has no cidrs", r.Protocol, r.From, r.To)
+	}
+	for i, s := range r.Cidrs {
+		cidr, _, err := ParseCidr(s)
+		if err != nil {
+			return err
+		}
+		r.Cidrs[i] = cidr
+	}
+	return nil
+}
+
+// ApplyDiff compares the ip ranges group allows with inputs. It returns the
+// inputs missing from the group and, only if prune is true, inputs for
+// ranges the group allows that are not wanted.
+func ApplyDiff(group *ec2.SecurityGroup, inputs []Input, prune bool) (add, remove []Input) {
+	want := map[string]bool{}
+	for _, input := range inputs {
+		want[inputKey(input)] = true
+	}
+
+	have := map[string]bool{}
+	for _, perm := range group.IpPermissions {
+		for _, cidr := range Ranges(perm) {
+			key := permKey(perm, cidr)
+			have[key] = true
+			if !want[key] && prune {
+				remove = append(remove, permInput(perm, cidr))
+			}
+		}
+	}
+
+	for _, input := range inputs {
+		if key := inputKey(input); !have[key] {
+			add = append(add, input)
+			have[key] = true // skip duplicates in inputs
+		}
+	}
+
+	return add, remove
+}
+
+// identity of protocol and port range; all traffic rules have no ports,
+// whether EC2 gives them as -1 or leaves them out
+func portKey(protocol *string, from, to *int64) string {
+	if aws.StringValue(protocol) == "-1" {
+		return "-1"
+	}
+	return fmt.Sprintf("%v/%v-%v", aws.StringValue(protocol), aws.Int64Value(from), aws.Int64Value(to))
+}
+
+// identity of a single rule in a permission
+func permKey(perm *ec2.IpPermission, cidr string) string {
+	return portKey(perm.IpProtocol, perm.FromPort, perm.ToPort) + "/" + cidr
+}
+
+// identity of the rule for input
+func inputKey(input Input) string {
+	return portKey(input.IpProtocol, input.FromPort, input.ToPort) + "/" + input.Cidr()
+}
@@ -0,0 +1,104 @@
+package letmein
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"strings"
+	"testing"
+)
+
+func TestParseRuleSet(t *testing.T) {
+	rules, err := ParseRuleSet([]byte(`
+groups:
+  - name: web
+    ingress:
+      - port: 22
+        cidrs: [198.51.100.7, 2001:db8::/64]
+      - protocol: udp
+        from: 60000
+        to: 61000
+        cidrs: [203.0.113.0/24]
+        description: mosh
+      - protocol: all
+        cidrs: [10.0.0.0/8]
+  - id: sg-1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules.Groups) != 2 || rules.Groups[1].String() != "sg-1" {
+		t.Fatalf("unexpected groups: %v", rules.Groups)
+	}
+	inputs := rules.Groups[0].Inputs()
+	if len(inputs) != 4 || inputs[0].Cidr() != "198.51.100.7/32" || *inputs[0].IpProtocol != "tcp" || *inputs[0].ToPort != 22 {
+		t.Errorf("unexpected inputs: %v", inputs)
+	}
+	if *inputs[1].CidrIpv6 != "2001:db8::/64" || *inputs[2].FromPort != 60000 || *inputs[2].ToPort != 61000 || *inputs[2].Description != "mosh" {
+		t.Errorf("unexpected inputs: %v", inputs)
+	}
+	if *inputs[3].IpProtocol != "-1" || *inputs[3].FromPort != -1 || *inputs[3].ToPort != -1 {
+		t.Errorf("expected all traffic with ports -1, got %v", inputs[3])
+	}
+}
+
+func TestParseRuleSetErrors(t *testing.T) {
+	for _, test := range []struct {
+		yaml string
+		err  string
+	}{
+		{"groups:\n  - ingress: []\n", "no id or name"},
+		{"groups:\n  - name: web\n    ingress:\n      - port: 22\n", "no cidrs"},
+		{"groups:\n  - name: web\n    ingress:\n      - port: 22\n        cidrs: [office]\n", "invalid cidr"},
+		{"groups:\n  - name: web\n    ingress:\n      - protocol: gre\n        cidrs: [10.0.0.0/8]\n", "unknown protocol"},
+		{"groups:\n  - name: web\n    ingress:\n      - from: 90\n        to: 80\n        cidrs: [10.0.0.0/8]\n", "invalid port range"},
+		{"groups:\n  - name: web\n    ingress:\n      - port: 22\n        from: 22\n        cidrs: [10.0.0.0/8]\n", "also gives from"},
+		{"groups:\n  - name: web\n    ingress:\n      - protocol: tcp\n        cidrs: [10.0.0.0/8]\n", "no port or range"},
+		{"groups:\n  - name: web\n    ingress:\n      - protocol: -1\n        port: 22\n        cidrs: [10.0.0.0/8]\n", "all traffic gives ports"},
+		{"groups:\n  - name: web\n    rules: []\n", "not found"},
+	} {
+		_, err := ParseRuleSet([]byte(test.yaml))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%q: expected error containing %q, got %v", test.yaml, test.err, err)
+		}
+	}
+}
+
+func TestApplyDiff(t *testing.T) {
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "198.51.100.1/32")
+	f.add("sg-0", "tcp", 22, "198.51.100.9/32")
+	f.add("sg-0", "tcp", 443, "0.0.0.0/0")
+	groups, _ := GetGroups(context.Background(), f, []string{"web"}, "group-name")
+
+	inputs := []Input{sshInput("198.51.100.1/32"), sshInput("198.51.100.2/32"), sshInput("198.51.100.2/32")}
+
+	// without prune nothing is removed, even on ports in the file
+	add, remove := ApplyDiff(groups[0], inputs, false)
+	if len(add) != 1 || add[0].Cidr() != "198.51.100.2/32" {
+		t.Errorf("unexpected additions: %v", add)
+	}
+	if len(remove) != 0 {
+		t.Errorf("expected no removals without prune, got %v", remove)
+	}
+
+	_, remove = ApplyDiff(groups[0], inputs, true)
+	if len(remove) != 2 || remove[0].Cidr() != "198.51.100.9/32" || remove[1].Cidr() != "0.0.0.0/0" || *remove[1].FromPort != 443 {
+		t.Errorf("unexpected removals with prune: %v", remove)
+	}
+
+	// all traffic rules listed in the file are kept, however EC2 gives their ports
+	groups[0].IpPermissions = append(groups[0].IpPermissions, &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("192.0.2.0/24")}},
+	})
+	rules, err := ParseRuleSet([]byte("groups:\n  - name: web\n    ingress:\n      - protocol: -1\n        cidrs: [192.0.2.0/24]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	add, remove = ApplyDiff(groups[0], append(inputs, rules.Groups[0].Inputs()...), true)
+	if len(add) != 1 || len(remove) != 2 {
+		t.Errorf("expected all traffic rule kept, got additions %v, removals %v", add, remove)
+	}
+}
@@ -0,0 +1,113 @@
+package letmein
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// layout of snapshot file names, which sort by time
+const snapshotLayout = "20060102T150405.000Z"
+
+// Snapshot records the ingress rules of security groups at a point in time,
+// so they can be restored if they are removed by mistake.
+type Snapshot struct {
+	Time   time.Time        `json:"time"`
+	Region string           `json:"region,omitempty"`
+	Groups []*SnapshotGroup `json:"groups"`
+}
+
+// SnapshotGroup is the saved state of a single security group.
+type SnapshotGroup struct {
+	GroupId       string              `json:"group_id"`
+	GroupName     string              `json:"group_name"`
+	IpPermissions []*ec2.IpPermission `json:"ip_permissions"`
+}
+
+// NewSnapshot returns a snapshot of the current rules of groups.
+func NewSnapshot(groups []*ec2.SecurityGroup, region string) *Snapshot {
+	snapshot := &Snapshot{Time: time.Now().UTC(), Region: region}
+	for _, group := range groups {
+		snapshot.Groups = append(snapshot.Groups, &SnapshotGroup{
+			GroupId:       aws.StringValue(group.GroupId),
+			GroupName:     aws.StringValue(group.GroupName),
+			IpPermissions: group.IpPermissions,
+		})
+	}
+	return snapshot
+}
+
+// WriteSnapshot saves snapshot as JSON to a file in dir named for its time,
+// creating dir if needed, and returns the path of the file.
+func WriteSnapshot(dir string, snapshot *Snapshot) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, snapshot.Time.UTC().Format(snapshotLayout)+".json")
+	if err := ioutil.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ReadSnapshot loads a snapshot saved by WriteSnapshot.
+func ReadSnapshot(path string) (*Snapshot, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("%v: invalid snapshot: %v", path, err)
+	}
+	return snapshot, nil
+}
+
+// LatestSnapshot returns the path of the newest snapshot in dir.
+func LatestSnapshot(dir string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return "", err
+	}
+	if len(paths) == 0 {
+		return "", fmt.Errorf("no snapshots in %v", dir)
+	}
+
+	sort.Strings(paths)
+	return paths[len(paths)-1], nil
+}
+
+// RestoreDiff returns inputs for each ip range in saved that group no
+// longer allows, so that authorizing them restores the saved rules.
+// Ranges added since the snapshot are left alone.
+func RestoreDiff(group *ec2.SecurityGroup, saved *SnapshotGroup) []Input {
+	have := map[string]bool{}
+	for _, perm := range group.IpPermissions {
+		for _, cidr := range Ranges(perm) {
+			have[permKey(perm, cidr)] = true
+		}
+	}
+
+	missing := []Input{}
+	for _, perm := range saved.IpPermissions {
+		for _, cidr := range Ranges(perm) {
+			if !have[permKey(perm, cidr)] {
+				missing = append(missing, permInput(perm, cidr))
+			}
+		}
+	}
+	return missing
+}
@@ -0,0 +1,70 @@
+package letmein
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "let-me-in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := newFakeEC2("web")
+	f.add("sg-0", "tcp", 22, "198.51.100.1/32")
+	f.add("sg-0", "tcp", 443, "2001:db8::/64")
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	older := NewSnapshot(groups, "us-east-1")
+	older.Time = older.Time.Add(-time.Hour)
+	if _, err := WriteSnapshot(dir, older); err != nil {
+		t.Fatal(err)
+	}
+	path, err := WriteSnapshot(dir, NewSnapshot(groups, "us-east-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest, err := LatestSnapshot(dir); err != nil || latest != path {
+		t.Errorf("expected latest snapshot %v, got %v %v", path, latest, err)
+	}
+
+	// clean the group, and add a rule that restore should leave alone
+	if err := CleanGroups(ctx, f, groups); err != nil {
+		t.Fatal(err)
+	}
+	f.add("sg-0", "tcp", 22, "203.0.113.1/32")
+
+	snapshot, err := ReadSnapshot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, _ = GetGroups(ctx, f, []string{"web"}, "group-name")
+	missing := RestoreDiff(groups[0], snapshot.Groups[0])
+	if len(missing) != 2 {
+		t.Fatalf("expected 2 missing rules, got %v", missing)
+	}
+	for _, input := range missing {
+		if err := AuthorizeGroup(ctx, f, groups[0], input); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !f.has("sg-0", "tcp", 22, "198.51.100.1/32") || !f.has("sg-0", "tcp", 443, "2001:db8::/64") || !f.has("sg-0", "tcp", 22, "203.0.113.1/32") {
+		t.Errorf("rules not restored: %v", f.group("sg-0"))
+	}
+	groups, _ = GetGroups(ctx, f, []string{"web"}, "group-name")
+	if missing := RestoreDiff(groups[0], snapshot.Groups[0]); len(missing) != 0 {
+		t.Errorf("expected nothing missing after restore, got %v", missing)
+	}
+
+	if _, err := LatestSnapshot(filepath.Join(dir, "empty")); err == nil {
+		t.Error("expected error for directory without snapshots")
+	}
+}
@@ -0,0 +1,182 @@
+package letmein
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// largest ip list we will fetch
+const maxSourceSize = 10 << 20
+
+// FetchCidrs returns the cidr blocks published at url, such as the egress
+// ranges of a CI or VPN provider. The list may be plain text with one
+// address or block per line, and # comments, or JSON. For JSON, path
+// selects the blocks with a subset of jq syntax, e.g. .prefixes[].ip_prefix;
+// with no path the document must be an array of strings.
+func FetchCidrs(ctx context.Context, url, path string) ([]string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v: %v", url, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", url, err)
+	}
+	if len(body) > maxSourceSize {
+		return nil, fmt.Errorf("%v: list is larger than %d bytes", url, maxSourceSize)
+	}
+
+	cidrs, err := ParseCidrList(body, path)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", url, err)
+	}
+	return cidrs, nil
+}
+
+// ParseCidrList parses a list of cidr blocks as plain text, or as JSON if
+// path is given or the body looks like JSON. Addresses become /32 or /128.
+func ParseCidrList(body []byte, path string) ([]string, error) {
+	var entries []string
+
+	trimmed := bytes.TrimSpace(body)
+	if path != "" || bytes.HasPrefix(trimmed, []byte("[")) || bytes.HasPrefix(trimmed, []byte("{")) {
+		var doc interface{}
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %v", err)
+		}
+
+		values, err := jqPath(doc, path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range values {
+			switch v := v.(type) {
+			case nil:
+				// missing key, as jq gives null
+			case string:
+				entries = append(entries, v)
+			case []interface{}:
+				for _, e := range v {
+					s, ok := e.(string)
+					if !ok {
+						return nil, fmt.Errorf("path %q selects a list containing %v, not strings", path, e)
+					}
+					entries = append(entries, s)
+				}
+			default:
+				return nil, fmt.Errorf("path %q selects %v, not strings; try adding [] or a key", path, v)
+			}
+		}
+	} else {
+		for _, line := range strings.Split(string(body), "\n") {
+			if i := strings.Index(line, "#"); i >= 0 {
+				line = line[:i]
+			}
+			entries = append(entries, strings.Fields(line)...)
+		}
+	}
+
+	cidrs := []string{}
+	for _, entry := range entries {
+		cidr, _, err := ParseCidr(entry)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
+
+// values selected from a JSON document by a jq path made of .key, [] to
+// iterate over an array or object, and [n] to index an array
+func jqPath(doc interface{}, path string) ([]interface{}, error) {
+	values := []interface{}{doc}
+	rest := strings.TrimSpace(path)
+
+	for rest != "" {
+		next := []interface{}{}
+
+		switch {
+		case strings.HasPrefix(rest, "[]"):
+			rest = rest[2:]
+			for _, v := range values {
+				switch v := v.(type) {
+				case []interface{}:
+					next = append(next, v...)
+				case map[string]interface{}:
+					for _, e := range v {
+						next = append(next, e)
+					}
+				case nil:
+				default:
+					return nil, fmt.Errorf("path %q: cannot iterate over %v", path, v)
+				}
+			}
+
+		case rest[0] == '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("path %q: missing ]", path)
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("path %q: invalid index %v", path, rest[1:end])
+			}
+			rest = rest[end+1:]
+			for _, v := range values {
+				a, ok := v.([]interface{})
+				if !ok && v != nil {
+					return nil, fmt.Errorf("path %q: cannot index %v", path, v)
+				}
+				if i >= 0 && i < len(a) {
+					next = append(next, a[i])
+				}
+			}
+
+		case rest[0] == '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			rest = rest[end:]
+			if key == "" {
+				continue // . alone, or .[] is the same as []
+			}
+			for _, v := range values {
+				m, ok := v.(map[string]interface{})
+				if !ok && v != nil {
+					return nil, fmt.Errorf("path %q: cannot get key %v of %v", path, key, v)
+				}
+				next = append(next, m[key])
+			}
+
+		default:
+			return nil, fmt.Errorf("path %q: expected . or [ at %q", path, rest)
+		}
+
+		values = next
+	}
+
+	return values, nil
+}
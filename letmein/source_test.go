@@ -0,0 +1,67 @@
+package letmein
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestParseCidrList(t *testing.T) {
+	for _, test := range []struct {
+		body  string
+		path  string
+		cidrs string
+	}{
+		{"# egress\n198.51.100.0/24\n\n198.51.100.7 # bastion\n", "", "198.51.100.0/24 198.51.100.7/32"},
+		{`["198.51.100.0/24", "2001:db8::/48"]`, "", "198.51.100.0/24 2001:db8::/48"},
+		{`{"actions": ["198.51.100.0/24"], "web": ["203.0.113.0/24"]}`, ".actions", "198.51.100.0/24"},
+		{`{"actions": ["198.51.100.0/24"], "web": ["203.0.113.0/24"]}`, ".actions[]", "198.51.100.0/24"},
+		{`{"prefixes": [{"ip_prefix": "198.51.100.0/24"}, {"ip_prefix": "203.0.113.0/24"}, {"ipv6_prefix": "2001:db8::/48"}]}`, ".prefixes[].ip_prefix", "198.51.100.0/24 203.0.113.0/24"},
+		{`{"vpn": {"egress": [["198.51.100.1"], ["198.51.100.2"]]}}`, ".vpn.egress[1]", "198.51.100.2/32"},
+		{`[]`, "", ""},
+	} {
+		cidrs, err := ParseCidrList([]byte(test.body), test.path)
+		if err != nil {
+			t.Errorf("%q %v: %v", test.body, test.path, err)
+		} else if strings.Join(cidrs, " ") != test.cidrs {
+			t.Errorf("%q %v: expected %v, got %v", test.body, test.path, test.cidrs, cidrs)
+		}
+	}
+}
+
+func TestParseCidrListErrors(t *testing.T) {
+	for _, test := range []struct {
+		body string
+		path string
+		err  string
+	}{
+		{"198.51.100.0/24\nnot-an-address\n", "", "invalid cidr"},
+		{`{"actions": [`, "", "invalid JSON"},
+		{`{"actions": ["198.51.100.0/24"]}`, "", "not strings"},
+		{`{"actions": [{"cidr": "198.51.100.0/24"}]}`, ".actions", "not strings"},
+		{`{"actions": "198.51.100.0/24"}`, ".actions[]", "cannot iterate"},
+		{`{"actions": []}`, "actions", "expected . or ["},
+		{`{"actions": []}`, ".actions[x]", "invalid index"},
+	} {
+		_, err := ParseCidrList([]byte(test.body), test.path)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%q %v: expected error containing %q, got %v", test.body, test.path, test.err, err)
+		}
+	}
+}
+
+func TestFetchCidrs(t *testing.T) {
+	ts := newIdentServer(200, `{"prefixes": [{"ip_prefix": "198.51.100.0/24"}]}`)
+	defer ts.Close()
+
+	cidrs, err := FetchCidrs(context.Background(), ts.URL, ".prefixes[].ip_prefix")
+	if err != nil || len(cidrs) != 1 || cidrs[0] != "198.51.100.0/24" {
+		t.Errorf("expected 198.51.100.0/24, got %v %v", cidrs, err)
+	}
+
+	down := newIdentServer(404, "not found")
+	defer down.Close()
+	if _, err := FetchCidrs(context.Background(), down.URL, ""); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected 404 error, got %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+package letmein
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// STUN (RFC 5389) constants for a Binding request
+const (
+	stunBindingRequest  = 0x0001
+	stunBindingResponse = 0x0101
+	stunMagicCookie     = 0x2112a442
+	stunMappedAddress   = 0x0001
+	stunXorMappedAddr   = 0x0020
+	stunHeaderLen       = 20
+	stunDefaultPort     = "3478"
+)
+
+// how often to resend request over lossy udp
+var stunRetransmit = 500 * time.Millisecond
+
+// how long to wait for an answer if ctx has no deadline
+var stunTimeout = DefaultIdentTimeout
+
+// ask STUN server at stun:host[:port] for our public mapped address
+func getStunIp(ctx context.Context, source string) (net.IP, error) {
+	addr := strings.TrimPrefix(source, "stun:")
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, stunDefaultPort)
+	}
+
+	// never wait forever on a silent server
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, stunTimeout)
+		defer cancel()
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", source, err)
+	}
+	defer conn.Close()
+
+	end, _ := ctx.Deadline()
+	conn.SetDeadline(end)
+
+	req, txid, err := newStunRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		if _, err := conn.Write(req); err != nil {
+			return nil, fmt.Errorf("%v: %v", source, stunError(ctx, end, err))
+		}
+
+		// wait for answer until time to resend, or ctx deadline
+		deadline := time.Now().Add(stunRetransmit)
+		if end.Before(deadline) {
+			deadline = end
+		}
+		conn.SetReadDeadline(deadline)
+
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				if ctx.Err() != nil || !time.Now().Before(end) {
+					return nil, fmt.Errorf("%v: %v", source, stunError(ctx, end, err))
+				}
+				if e, ok := err.(net.Error); ok && e.Timeout() {
+					break // resend
+				}
+				// e.g. connection refused from icmp port unreachable
+				return nil, fmt.Errorf("%v: %v", source, err)
+			}
+
+			ip, err := parseStunResponse(buf[:n], txid)
+			if err == errStunIgnore {
+				continue // stray packet
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", source, err)
+			}
+			return ip, nil
+		}
+	}
+}
+
+// err from conn, or the ctx error if it is done or its deadline has passed
+func stunError(ctx context.Context, end time.Time, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if !time.Now().Before(end) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+// binding request with random transaction id
+func newStunRequest() ([]byte, []byte, error) {
+	req := make([]byte, stunHeaderLen)
+	binary.BigEndian.PutUint16(req[0:], stunBindingRequest)
+	binary.BigEndian.PutUint16(req[2:], 0)
+	binary.BigEndian.PutUint32(req[4:], stunMagicCookie)
+	if _, err := rand.Read(req[8:20]); err != nil {
+		return nil, nil, err
+	}
+	return req, req[8:20], nil
+}
+
+var errStunIgnore = fmt.Errorf("not a response to our request")
+
+// get mapped address from binding response for transaction id
+func parseStunResponse(msg []byte, txid []byte) (net.IP, error) {
+	if len(msg) < stunHeaderLen || binary.BigEndian.Uint32(msg[4:]) != stunMagicCookie || string(msg[8:20]) != string(txid) {
+		return nil, errStunIgnore
+	}
+	if t := binary.BigEndian.Uint16(msg[0:]); t != stunBindingResponse {
+		return nil, fmt.Errorf("unexpected stun message type 0x%04x", t)
+	}
+
+	length := int(binary.BigEndian.Uint16(msg[2:]))
+	if stunHeaderLen+length > len(msg) {
+		return nil, fmt.Errorf("truncated stun response")
+	}
+	attrs := msg[stunHeaderLen : stunHeaderLen+length]
+
+	// prefer xor-mapped address, as some NATs rewrite plain addresses in payloads
+	var mapped net.IP
+	for len(attrs) >= 4 {
+		t := binary.BigEndian.Uint16(attrs[0:])
+		l := int(binary.BigEndian.Uint16(attrs[2:]))
+		if 4+l > len(attrs) {
+			return nil, fmt.Errorf("truncated stun attribute")
+		}
+		value := attrs[4 : 4+l]
+
+		switch t {
+		case stunXorMappedAddr:
+			return decodeStunAddress(value, msg[4:20])
+		case stunMappedAddress:
+			mapped, _ = decodeStunAddress(value, nil)
+		}
+
+		// attributes are padded to 4 bytes
+		next := 4 + (l+3)&^3
+		if next > len(attrs) {
+			break
+		}
+		attrs = attrs[next:]
+	}
+
+	if mapped == nil {
+		return nil, fmt.Errorf("no mapped address in stun response")
+	}
+	return mapped, nil
+}
+
+// decode address attribute, xored with cookie and transaction id if given
+func decodeStunAddress(value []byte, xor []byte) (net.IP, error) {
+	if len(value) < 4 {
+		return nil, fmt.Errorf("short stun address")
+	}
+
+	var size int
+	switch value[1] {
+	case 0x01:
+		size = net.IPv4len
+	case 0x02:
+		size = net.IPv6len
+	default:
+		return nil, fmt.Errorf("unknown stun address family %d", value[1])
+	}
+	if len(value) < 4+size {
+		return nil, fmt.Errorf("short stun address")
+	}
+
+	ip := make(net.IP, size)
+	copy(ip, value[4:4+size])
+	if xor != nil {
+		for i := range ip {
+			ip[i] ^= xor[i]
+		}
+	}
+	return ip, nil
+}
@@ -0,0 +1,157 @@
+package letmein
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// tiny STUN responder; reply builds the response for each request
+func newStunServer(t *testing.T, reply func(req []byte, from *net.UDPAddr) [][]byte) (string, func()) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			for _, msg := range reply(buf[:n], from.(*net.UDPAddr)) {
+				conn.WriteTo(msg, from)
+			}
+		}
+	}()
+
+	return "stun:" + conn.LocalAddr().String(), func() { conn.Close() }
+}
+
+// binding response for request with a single address attribute
+func stunResponse(req []byte, attr uint16, ip net.IP, port int) []byte {
+	ip4 := ip.To4()
+	value := make([]byte, 8)
+	value[1] = 0x01
+	binary.BigEndian.PutUint16(value[2:], uint16(port))
+	copy(value[4:], ip4)
+	if attr == stunXorMappedAddr {
+		binary.BigEndian.PutUint16(value[2:], uint16(port)^uint16(stunMagicCookie>>16))
+		for i := 0; i < 4; i++ {
+			value[4+i] ^= req[4+i]
+		}
+	}
+
+	msg := make([]byte, stunHeaderLen+4+len(value))
+	binary.BigEndian.PutUint16(msg[0:], stunBindingResponse)
+	binary.BigEndian.PutUint16(msg[2:], uint16(4+len(value)))
+	copy(msg[4:20], req[4:20])
+	binary.BigEndian.PutUint16(msg[20:], attr)
+	binary.BigEndian.PutUint16(msg[22:], uint16(len(value)))
+	copy(msg[24:], value)
+	return msg
+}
+
+func TestGetStunIp(t *testing.T) {
+	for _, attr := range []uint16{stunXorMappedAddr, stunMappedAddress} {
+		source, done := newStunServer(t, func(req []byte, from *net.UDPAddr) [][]byte {
+			return [][]byte{stunResponse(req, attr, net.ParseIP("203.0.113.7"), from.Port)}
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		ip, err := GetMyIp(ctx, source)
+		cancel()
+		done()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ip.String() != "203.0.113.7" {
+			t.Errorf("attr 0x%04x: expected 203.0.113.7, got %v", attr, ip)
+		}
+	}
+}
+
+func TestGetStunIpIgnoresStrayPackets(t *testing.T) {
+	source, done := newStunServer(t, func(req []byte, from *net.UDPAddr) [][]byte {
+		stray := stunResponse(req, stunXorMappedAddr, net.ParseIP("198.51.100.1"), from.Port)
+		stray[19] ^= 0xff // different transaction id
+		return [][]byte{stray, []byte("junk"), stunResponse(req, stunXorMappedAddr, net.ParseIP("203.0.113.7"), from.Port)}
+	})
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ip, err := GetMyIp(ctx, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip.String() != "203.0.113.7" {
+		t.Errorf("expected 203.0.113.7, got %v", ip)
+	}
+}
+
+func TestGetStunIpRetransmit(t *testing.T) {
+	defer func(d time.Duration) { stunRetransmit = d }(stunRetransmit)
+	stunRetransmit = 20 * time.Millisecond
+
+	// drop first two requests
+	requests := 0
+	source, done := newStunServer(t, func(req []byte, from *net.UDPAddr) [][]byte {
+		requests++
+		if requests < 3 {
+			return nil
+		}
+		return [][]byte{stunResponse(req, stunXorMappedAddr, net.ParseIP("203.0.113.7"), from.Port)}
+	})
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := GetMyIp(ctx, source); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetStunIpTimeout(t *testing.T) {
+	source, done := newStunServer(t, func(req []byte, from *net.UDPAddr) [][]byte {
+		return nil
+	})
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := GetMyIp(ctx, source)
+	if err == nil || !strings.Contains(err.Error(), "deadline") {
+		t.Errorf("expected deadline error, got %v", err)
+	}
+}
+
+func TestGetStunIpTimeoutWithoutDeadline(t *testing.T) {
+	defer func(d time.Duration) { stunTimeout = d }(stunTimeout)
+	stunTimeout = 50 * time.Millisecond
+
+	source, done := newStunServer(t, func(req []byte, from *net.UDPAddr) [][]byte {
+		return nil
+	})
+	defer done()
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := GetMyIp(context.Background(), source)
+		result <- err
+	}()
+
+	select {
+	case err := <-result:
+		if err == nil || !strings.Contains(err.Error(), "deadline") {
+			t.Errorf("expected deadline error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no answer from silent server, still waiting")
+	}
+}
@@ -0,0 +1,62 @@
+package letmein
+
+import (
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// SyncDiff compares the ip ranges group allows on the protocol and ports
+// of input with cidrs. It returns inputs for each of cidrs missing from the
+// group, and for each range synced from source before, in family, that is
+// no longer in cidrs. Input should carry a description recording a Grant
+// with Sync set to source, so that later syncs find the ranges it adds.
+// Other ranges, such as baseline rules and grants, are left alone.
+func SyncDiff(group *ec2.SecurityGroup, input Input, cidrs []string, source string, family Family) (add, remove []Input) {
+	want := map[string]bool{}
+	for _, cidr := range cidrs {
+		want[cidr] = true
+	}
+
+	have := map[string]bool{}
+	for _, perm := range group.IpPermissions {
+		if !samePorts(perm, input) {
+			continue
+		}
+		for _, cidr := range Ranges(perm) {
+			have[cidr] = true
+			if !want[cidr] && inFamily(cidr, family) && syncedFrom(RangeDescription(perm, cidr), source) {
+				remove = append(remove, permInput(perm, cidr))
+			}
+		}
+	}
+
+	for _, cidr := range cidrs {
+		if !have[cidr] {
+			add = append(add, input.WithCidr(cidr))
+			have[cidr] = true // skip duplicates in cidrs
+		}
+	}
+
+	return add, remove
+}
+
+// true if rule description says it was added by a sync from source
+func syncedFrom(description, source string) bool {
+	grant, ok := ParseGrant(description)
+	return ok && grant.Sync != "" && grant.Sync == syncMarker(source)
+}
+
+// true if cidr is of an address family in family
+func inFamily(cidr string, family Family) bool {
+	if IsIPv6(cidr) {
+		return family&IPv6 != 0
+	}
+	return family&IPv4 != 0
+}
+
+// true if permission is for the same protocol and port range as input
+func samePorts(perm *ec2.IpPermission, input Input) bool {
+	return aws.StringValue(perm.IpProtocol) == aws.StringValue(input.IpProtocol) &&
+		aws.Int64Value(perm.FromPort) == aws.Int64Value(input.FromPort) &&
+		aws.Int64Value(perm.ToPort) == aws.Int64Value(input.ToPort)
+}
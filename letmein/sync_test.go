@@ -0,0 +1,95 @@
+package letmein
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"strings"
+	"testing"
+)
+
+// ssh input marked as synced from source
+func syncInput(source string) Input {
+	input := sshInput("")
+	input.Description = aws.String(Grant{User: "alice", Sync: source}.Description())
+	return input
+}
+
+func TestSyncDiff(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web")
+	synced := syncInput("https://example.com/ips").Description
+	f.addDescribed("sg-0", "tcp", 22, "198.51.100.1/32", *synced)
+	f.addDescribed("sg-0", "tcp", 22, "198.51.100.9/32", *synced)
+	f.addDescribed("sg-0", "tcp", 443, "198.51.100.9/32", *synced)
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	input := syncInput("https://example.com/ips")
+	add, remove := SyncDiff(groups[0], input, []string{"198.51.100.1/32", "198.51.100.2/32", "198.51.100.2/32", "2001:db8::1/128"}, "https://example.com/ips", DualStack)
+
+	if len(add) != 2 || add[0].Cidr() != "198.51.100.2/32" || add[1].CidrIpv6 == nil || *add[1].FromPort != 22 || add[0].Description != input.Description {
+		t.Errorf("unexpected additions: %v", add)
+	}
+	if len(remove) != 1 || remove[0].Cidr() != "198.51.100.9/32" || *remove[0].FromPort != 22 {
+		t.Errorf("unexpected removals: %v", remove)
+	}
+
+	// applying the diff leaves exactly the wanted cidrs, and other ports alone
+	for _, input := range add {
+		AuthorizeGroup(ctx, f, groups[0], input)
+	}
+	for _, input := range remove {
+		RevokeGroup(ctx, f, groups[0], input)
+	}
+	groups, _ = GetGroups(ctx, f, []string{"web"}, "group-name")
+	if add, remove := SyncDiff(groups[0], input, []string{"198.51.100.1/32", "198.51.100.2/32", "2001:db8::1/128"}, "https://example.com/ips", DualStack); len(add)+len(remove) != 0 {
+		t.Errorf("expected no changes after sync, got %v %v", add, remove)
+	}
+	if !f.has("sg-0", "tcp", 443, "198.51.100.9/32") {
+		t.Error("sync touched another port")
+	}
+}
+
+func TestSyncDiffKeepsOtherRules(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeEC2("web")
+	f.addDescribed("sg-0", "tcp", 22, "198.51.100.1/32", *syncInput("office.example.com").Description)
+	f.addDescribed("sg-0", "tcp", 22, "2001:db8::1/128", *syncInput("office.example.com").Description) // synced IPv6
+	f.add("sg-0", "tcp", 22, "10.0.0.0/8")                                                             // baseline
+	f.addDescribed("sg-0", "tcp", 22, "203.0.113.7/32", Grant{User: "bob"}.Description())              // someone's grant
+	f.addDescribed("sg-0", "tcp", 22, "192.0.2.0/24", *syncInput("vpn.example.com").Description)       // another sync
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	// IPv4 sync from office removes only its own IPv4 rule
+	_, remove := SyncDiff(groups[0], syncInput("office.example.com"), []string{"198.51.100.2/32"}, "office.example.com", IPv4)
+	if len(remove) != 1 || remove[0].Cidr() != "198.51.100.1/32" {
+		t.Errorf("expected only the synced IPv4 rule removed, got %v", remove)
+	}
+
+	// both families also remove its IPv6 rule
+	_, remove = SyncDiff(groups[0], syncInput("office.example.com"), []string{"198.51.100.2/32"}, "office.example.com", DualStack)
+	if len(remove) != 2 || remove[1].Cidr() != "2001:db8::1/128" {
+		t.Errorf("expected synced IPv4 and IPv6 rules removed, got %v", remove)
+	}
+}
+
+func TestSyncDiffLongSource(t *testing.T) {
+	ctx := context.Background()
+	source := "https://ip-ranges.example.com/v1/egress?region=us-east-1&service=" + strings.Repeat("build-agents,", 20)
+	grant := Grant{User: "ci", Host: strings.Repeat("runner", 10), Ticket: strings.Repeat("OPS-1", 10), Sync: source, Reason: strings.Repeat("x", 200)}
+	d := grant.Description()
+	if len(d) > maxDescription || !strings.HasPrefix(d, "let-me-in sync=sha256:") {
+		t.Fatalf("unexpected description %q", d)
+	}
+
+	f := newFakeEC2("web")
+	f.addDescribed("sg-0", "tcp", 22, "198.51.100.9/32", d)
+	groups, _ := GetGroups(ctx, f, []string{"web"}, "group-name")
+
+	// stale range is still found as synced from the long source, and only from it
+	if _, remove := SyncDiff(groups[0], syncInput(source), []string{"198.51.100.1/32"}, source, IPv4); len(remove) != 1 {
+		t.Errorf("expected stale range removed, got %v", remove)
+	}
+	if _, remove := SyncDiff(groups[0], syncInput(source+"x"), []string{"198.51.100.1/32"}, source+"x", IPv4); len(remove) != 0 {
+		t.Errorf("expected range from another source kept, got %v", remove)
+	}
+}
@@ -0,0 +1,14 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package letmein
+
+import (
+	"errors"
+	"io"
+)
+
+// writer for the syslog audit sink, which needs a unix syslog daemon
+func openSyslog() (io.Writer, error) {
+	return nil, errors.New("syslog audit sink is not supported on this platform, use stdout or a file")
+}
@@ -0,0 +1,616 @@
+// Package simulator serves an in-memory subset of the EC2 query API for
+// security groups, so let-me-in can be demonstrated and tested without AWS.
+// Point an ec2 client at it by setting aws.Config.Endpoint.
+package simulator
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// default max number of cidrs per group and direction, as for real EC2
+const DefaultRuleLimit = 60
+
+// first EC2 API version with IPv6 ranges and rule descriptions; requests
+// for older versions using them are refused, as by real EC2
+const APIVersion = "2016-11-15"
+
+// single cidr allowed for protocol and port range
+type entry struct {
+	protocol    string
+	from, to    int64
+	cidr        string
+	description string // not part of rule identity
+}
+
+type group struct {
+	id, name, description, vpcId string
+	tags                         map[string]string
+	ingress, egress              []entry
+}
+
+// Server is an http.Handler speaking the EC2 query protocol.
+type Server struct {
+	mu       sync.Mutex
+	groups   []*group
+	faults   []*Fault
+	limit    int
+	requests int
+}
+
+// api error returned to client
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.code + ": " + e.message
+}
+
+func newError(code, format string, a ...interface{}) *apiError {
+	return &apiError{http.StatusBadRequest, code, fmt.Sprintf(format, a...)}
+}
+
+// New returns a server with given initial state.
+func New(state *State) (*Server, error) {
+	s := &Server{limit: state.RuleLimit}
+	if s.limit == 0 {
+		s.limit = DefaultRuleLimit
+	}
+
+	for i, g := range state.Groups {
+		if g.Id == "" {
+			g.Id = fmt.Sprintf("sg-%08x", i+1)
+		}
+		if s.group(g.Id) != nil {
+			return nil, fmt.Errorf("duplicate group id: %v", g.Id)
+		}
+
+		grp := &group{id: g.Id, name: g.Name, description: g.Description, vpcId: g.VpcId, tags: map[string]string{}}
+		for k, v := range g.Tags {
+			grp.tags[k] = v
+		}
+		grp.ingress = flatten(g.Ingress)
+		grp.egress = flatten(g.Egress)
+		s.groups = append(s.groups, grp)
+	}
+
+	for _, f := range state.Faults {
+		if f.Code == "" {
+			return nil, fmt.Errorf("error for action %q has no code", f.Action)
+		}
+		fault := *f
+		s.faults = append(s.faults, &fault)
+	}
+
+	return s, nil
+}
+
+// State returns a copy of the current groups and rules.
+func (s *Server) State() *State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := &State{RuleLimit: s.limit}
+	for _, g := range s.groups {
+		grp := &Group{Id: g.id, Name: g.name, Description: g.description, VpcId: g.vpcId, Tags: map[string]string{}}
+		for k, v := range g.tags {
+			grp.Tags[k] = v
+		}
+		grp.Ingress = unflatten(g.ingress)
+		grp.Egress = unflatten(g.egress)
+		state.Groups = append(state.Groups, grp)
+	}
+	return state
+}
+
+// convert rules from state into entries
+func flatten(rules []*Rule) []entry {
+	entries := []entry{}
+	for _, r := range rules {
+		from, to := r.From, r.To
+		if r.Port != 0 {
+			from, to = r.Port, r.Port
+		}
+		if r.Protocol == "-1" {
+			from, to = -1, -1 // as EC2 reports all traffic
+		}
+		for _, cidr := range r.Cidrs {
+			entries = append(entries, entry{r.Protocol, from, to, cidr, r.Descriptions[cidr]})
+		}
+	}
+	return entries
+}
+
+// group entries into rules by protocol and port range
+func unflatten(entries []entry) []*Rule {
+	rules := []*Rule{}
+	for _, e := range entries {
+		var rule *Rule
+		for _, r := range rules {
+			if r.Protocol == e.protocol && r.From == e.from && r.To == e.to {
+				rule = r
+			}
+		}
+		if rule == nil {
+			rule = &Rule{Protocol: e.protocol, From: e.from, To: e.to}
+			rules = append(rules, rule)
+		}
+		rule.Cidrs = append(rule.Cidrs, e.cidr)
+		if e.description != "" {
+			if rule.Descriptions == nil {
+				rule.Descriptions = map[string]string{}
+			}
+			rule.Descriptions[e.cidr] = e.description
+		}
+	}
+	return rules
+}
+
+func (s *Server) group(id string) *group {
+	for _, g := range s.groups {
+		if g.id == id {
+			return g
+		}
+	}
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	requestId := fmt.Sprintf("00000000-0000-0000-0000-%012d", s.requests)
+	action := r.Form.Get("Action")
+
+	var resp interface{}
+	err := checkVersion(r.Form)
+	if err == nil {
+		err = s.fault(action, r.Form.Get("GroupId"))
+	}
+	if err == nil {
+		switch action {
+		case "DescribeSecurityGroups":
+			resp, err = s.describeSecurityGroups(r.Form)
+		case "AuthorizeSecurityGroupIngress":
+			resp, err = s.authorize(r.Form, false)
+		case "AuthorizeSecurityGroupEgress":
+			resp, err = s.authorize(r.Form, true)
+		case "RevokeSecurityGroupIngress":
+			resp, err = s.revoke(r.Form, false)
+		case "RevokeSecurityGroupEgress":
+			resp, err = s.revoke(r.Form, true)
+		case "CreateTags":
+			resp, err = s.createTags(r.Form)
+		default:
+			err = newError("InvalidAction", "The action %v is not valid for this web service.", action)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+
+	if err != nil {
+		w.WriteHeader(err.status)
+		writeXML(w, &xmlErrorResponse{
+			Code:      err.code,
+			Message:   err.message,
+			RequestId: requestId,
+		})
+		return
+	}
+
+	writeXML(w, &xmlResponse{
+		XMLName:   xml.Name{Local: action + "Response"},
+		Xmlns:     "http://ec2.amazonaws.com/doc/" + APIVersion + "/",
+		RequestId: requestId,
+		Body:      resp,
+	})
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	fmt.Fprint(w, xml.Header)
+	xml.NewEncoder(w).Encode(v)
+}
+
+// error if request uses parameters unknown to its API version
+func checkVersion(form url.Values) *apiError {
+	version := form.Get("Version")
+	if version >= APIVersion {
+		return nil
+	}
+
+	keys := []string{}
+	for key := range form {
+		if strings.Contains(key, ".Ipv6Ranges.") || strings.Contains(key, "Ranges.") && strings.HasSuffix(key, ".Description") {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+	return newError("UnknownParameter", "The parameter %v is not recognized in API version %v", keys[0], version)
+}
+
+// return error from first matching fault, if any
+func (s *Server) fault(action, groupId string) *apiError {
+	for _, f := range s.faults {
+		if f.Action != "" && f.Action != action {
+			continue
+		}
+		if f.GroupId != "" && f.GroupId != groupId {
+			continue
+		}
+
+		if f.Count < 0 {
+			continue // used up
+		}
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				f.Count = -1
+			}
+		}
+
+		status := f.Status
+		if status == 0 {
+			status = http.StatusBadRequest
+			if f.Code == "RequestLimitExceeded" || f.Code == "Throttling" {
+				status = http.StatusServiceUnavailable
+			}
+		}
+		message := f.Message
+		if message == "" {
+			message = f.Code
+		}
+		return &apiError{status, f.Code, message}
+	}
+	return nil
+}
+
+func (s *Server) describeSecurityGroups(form url.Values) (interface{}, *apiError) {
+	ids := indexed(form, "GroupId")
+	names := indexed(form, "GroupName")
+	filters := members(form, "Filter")
+
+	// check filters are ones we understand
+	for _, f := range filters {
+		name := f.Get("Name")
+		switch {
+		case name == "group-name", name == "group-id", name == "vpc-id", name == "tag-key", strings.HasPrefix(name, "tag:"):
+		default:
+			return nil, newError("InvalidParameterValue", "The filter '%v' is invalid", name)
+		}
+	}
+
+	resp := &xmlDescribeSecurityGroups{}
+	for _, g := range s.groups {
+		if len(ids) > 0 && !contains(ids, g.id) {
+			continue
+		}
+		if len(names) > 0 && !contains(names, g.name) {
+			continue
+		}
+		if !g.matches(filters) {
+			continue
+		}
+		resp.Groups = append(resp.Groups, g.xml())
+	}
+
+	for _, id := range ids {
+		if s.group(id) == nil {
+			return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", id)
+		}
+	}
+
+	return resp, nil
+}
+
+// true if group matches all filters
+func (g *group) matches(filters []url.Values) bool {
+	for _, f := range filters {
+		name := f.Get("Name")
+		values := indexed(f, "Value")
+
+		var candidates []string
+		switch {
+		case name == "group-name":
+			candidates = []string{g.name}
+		case name == "group-id":
+			candidates = []string{g.id}
+		case name == "vpc-id":
+			candidates = []string{g.vpcId}
+		case name == "tag-key":
+			for k := range g.tags {
+				candidates = append(candidates, k)
+			}
+		case strings.HasPrefix(name, "tag:"):
+			if v, ok := g.tags[name[4:]]; ok {
+				candidates = []string{v}
+			}
+		}
+
+		found := false
+		for _, c := range candidates {
+			if contains(values, c) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// parse rules from request, either as flat params or IpPermissions
+func parseEntries(form url.Values) ([]entry, *apiError) {
+	entries := []entry{}
+
+	if form.Get("IpProtocol") != "" {
+		e, err := parseEntry(form.Get("IpProtocol"), form.Get("FromPort"), form.Get("ToPort"), form.Get("CidrIp"), false)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	for _, perm := range members(form, "IpPermissions") {
+		for _, r := range members(perm, "IpRanges") {
+			e, err := parseEntry(perm.Get("IpProtocol"), perm.Get("FromPort"), perm.Get("ToPort"), r.Get("CidrIp"), false)
+			if err != nil {
+				return nil, err
+			}
+			e.description = r.Get("Description")
+			entries = append(entries, e)
+		}
+		for _, r := range members(perm, "Ipv6Ranges") {
+			e, err := parseEntry(perm.Get("IpProtocol"), perm.Get("FromPort"), perm.Get("ToPort"), r.Get("CidrIpv6"), true)
+			if err != nil {
+				return nil, err
+			}
+			e.description = r.Get("Description")
+			entries = append(entries, e)
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, newError("MissingParameter", "No ip permissions given")
+	}
+	return entries, nil
+}
+
+func parseEntry(protocol, from, to, cidr string, ipv6 bool) (entry, *apiError) {
+	e := entry{protocol: protocol, cidr: cidr}
+
+	if protocol == "" {
+		return e, newError("MissingParameter", "The request must contain the parameter ipProtocol")
+	}
+	if cidr == "" {
+		return e, newError("MissingParameter", "The request must contain the parameter cidrIp")
+	}
+
+	// as EC2, IPv6 blocks must be given as CidrIpv6 and IPv4 as CidrIp
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil || (ip.To4() == nil) != ipv6 {
+		name := "CIDR"
+		if ipv6 {
+			name = "IPv6 CIDR"
+		}
+		return e, newError("InvalidParameterValue", "Value (%v) for parameter cidrIp is invalid. This is not a valid %v block.", cidr, name)
+	}
+
+	// all protocols has no ports
+	if protocol == "-1" {
+		e.from, e.to = -1, -1
+		return e, nil
+	}
+
+	if e.from, err = strconv.ParseInt(from, 10, 64); err != nil {
+		return e, newError("InvalidParameterValue", "Invalid value '%v' for fromPort", from)
+	}
+	if e.to, err = strconv.ParseInt(to, 10, 64); err != nil {
+		return e, newError("InvalidParameterValue", "Invalid value '%v' for toPort", to)
+	}
+	return e, nil
+}
+
+func (g *group) rules(egress bool) *[]entry {
+	if egress {
+		return &g.egress
+	}
+	return &g.ingress
+}
+
+// index of rule in entries, whatever its description
+func indexOf(entries []entry, e entry) int {
+	for i, x := range entries {
+		if x.protocol == e.protocol && x.from == e.from && x.to == e.to && x.cidr == e.cidr {
+			return i
+		}
+	}
+	return -1
+}
+
+// request group, which must exist
+func (s *Server) requestGroup(form url.Values) (*group, *apiError) {
+	id := form.Get("GroupId")
+	if id == "" {
+		// ingress may also be given by group name
+		if name := form.Get("GroupName"); name != "" {
+			for _, g := range s.groups {
+				if g.name == name {
+					return g, nil
+				}
+			}
+			return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", name)
+		}
+		return nil, newError("MissingParameter", "The request must contain the parameter groupId")
+	}
+
+	g := s.group(id)
+	if g == nil {
+		return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", id)
+	}
+	return g, nil
+}
+
+// add rules to group; either all are added or none
+func (s *Server) authorize(form url.Values, egress bool) (interface{}, *apiError) {
+	g, err := s.requestGroup(form)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := parseEntries(form)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := g.rules(egress)
+	for _, e := range entries {
+		if indexOf(*rules, e) >= 0 {
+			return nil, newError("InvalidPermission.Duplicate", "the specified rule \"peer: %v, %v, from port: %v, to port: %v, ALLOW\" already exists", e.cidr, strings.ToUpper(e.protocol), e.from, e.to)
+		}
+	}
+	if len(*rules)+len(entries) > s.limit {
+		return nil, newError("RulesPerSecurityGroupLimitExceeded", "The maximum number of rules per security group has been reached.")
+	}
+
+	*rules = append(*rules, entries...)
+	return &xmlReturn{Return: true}, nil
+}
+
+// remove rules from group; either all are removed or none
+func (s *Server) revoke(form url.Values, egress bool) (interface{}, *apiError) {
+	g, err := s.requestGroup(form)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := parseEntries(form)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := g.rules(egress)
+	for _, e := range entries {
+		if indexOf(*rules, e) < 0 {
+			return nil, newError("InvalidPermission.NotFound", "The specified rule does not exist in this security group.")
+		}
+	}
+
+	// remove by matching, as a request may list the same range twice
+	kept := []entry{}
+	for _, r := range *rules {
+		if indexOf(entries, r) < 0 {
+			kept = append(kept, r)
+		}
+	}
+	*rules = kept
+	return &xmlReturn{Return: true}, nil
+}
+
+func (s *Server) createTags(form url.Values) (interface{}, *apiError) {
+	ids := indexed(form, "ResourceId")
+	tags := members(form, "Tag")
+	if len(ids) == 0 || len(tags) == 0 {
+		return nil, newError("MissingParameter", "The request must contain the parameters resourceIdSet and tagSet")
+	}
+
+	groups := []*group{}
+	for _, id := range ids {
+		g := s.group(id)
+		if g == nil {
+			return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", id)
+		}
+		groups = append(groups, g)
+	}
+
+	for _, g := range groups {
+		for _, tag := range tags {
+			g.tags[tag.Get("Key")] = tag.Get("Value")
+		}
+	}
+	return &xmlReturn{Return: true}, nil
+}
+
+// values of simple list params, e.g. GroupId.1, GroupId.2, in order
+func indexed(form url.Values, prefix string) []string {
+	values := []string{}
+	for _, n := range indexes(form, prefix) {
+		values = append(values, form.Get(fmt.Sprintf("%v.%d", prefix, n)))
+	}
+	return values
+}
+
+// params of list of structures, e.g. Filter.1.Name, Filter.1.Value.1,
+// returned as one set of values per member keyed without the prefix
+func members(form url.Values, prefix string) []url.Values {
+	byIndex := map[int]url.Values{}
+	for key, values := range form {
+		if !strings.HasPrefix(key, prefix+".") {
+			continue
+		}
+		parts := strings.SplitN(key[len(prefix)+1:], ".", 2)
+		n, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) < 2 {
+			continue
+		}
+		if byIndex[n] == nil {
+			byIndex[n] = url.Values{}
+		}
+		byIndex[n][parts[1]] = values
+	}
+
+	ns := []int{}
+	for n := range byIndex {
+		ns = append(ns, n)
+	}
+	sort.Ints(ns)
+
+	result := make([]url.Values, len(ns))
+	for i, n := range ns {
+		result[i] = byIndex[n]
+	}
+	return result
+}
+
+// sorted indexes of params prefix.N
+func indexes(form url.Values, prefix string) []int {
+	ns := []int{}
+	for key := range form {
+		if !strings.HasPrefix(key, prefix+".") {
+			continue
+		}
+		if n, err := strconv.Atoi(key[len(prefix)+1:]); err == nil {
+			ns = append(ns, n)
+		}
+	}
+	sort.Ints(ns)
+	return ns
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
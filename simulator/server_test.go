@@ -0,0 +1,363 @@
+package simulator
+
+import (
+	"context"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/aws/session"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rlister/let-me-in/letmein"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// start simulator with state and return a client for it
+func newClient(t *testing.T, state *State, retries int) (*ec2.EC2, *Server, func()) {
+	sim, err := New(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(sim)
+
+	client := ec2.New(session.Must(session.NewSession()), &aws.Config{
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		MaxRetries:  aws.Int(retries),
+		SleepDelay:  func(time.Duration) {},
+	})
+	return client, sim, ts.Close
+}
+
+func seed() *State {
+	return &State{
+		Groups: []*Group{
+			{
+				Id:   "sg-1",
+				Name: "web",
+				Tags: map[string]string{"Name": "web-sg"},
+				Ingress: []*Rule{
+					{Protocol: "tcp", Port: 443, Cidrs: []string{"0.0.0.0/0"}},
+					{Protocol: "tcp", Port: 22, Cidrs: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+				},
+			},
+			{Id: "sg-2", Name: "db"},
+		},
+	}
+}
+
+func code(err error) string {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code()
+	}
+	return ""
+}
+
+func TestDescribeSecurityGroups(t *testing.T) {
+	client, _, done := newClient(t, seed(), 0)
+	defer done()
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		filter string
+		name   string
+		id     string
+	}{
+		{"group-name", "web", "sg-1"},
+		{"group-id", "sg-2", "sg-2"},
+		{"tag:Name", "web-sg", "sg-1"},
+	} {
+		groups, err := letmein.GetGroups(ctx, client, []string{test.name}, test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(groups) != 1 || *groups[0].GroupId != test.id {
+			t.Errorf("%v=%v: expected %v, got %v", test.filter, test.name, test.id, groups)
+		}
+	}
+
+	groups, _ := letmein.GetGroups(ctx, client, []string{"web"}, "group-name")
+	perms := groups[0].IpPermissions
+	if len(perms) != 2 || *perms[1].FromPort != 22 || len(perms[1].IpRanges) != 2 {
+		t.Errorf("unexpected permissions: %v", perms)
+	}
+
+	if _, err := letmein.GetGroups(ctx, client, []string{"x"}, "bogus"); code(err) != "InvalidParameterValue" {
+		t.Errorf("expected InvalidParameterValue, got %v", err)
+	}
+}
+
+func TestAllTraffic(t *testing.T) {
+	state := &State{Groups: []*Group{
+		{Id: "sg-1", Name: "all", Ingress: []*Rule{{Protocol: "-1", Cidrs: []string{"10.0.0.0/8"}}}},
+	}}
+	client, sim, done := newClient(t, state, 0)
+	defer done()
+	ctx := context.Background()
+
+	// seeded all traffic is reported with ports -1, as by EC2, and can be revoked
+	groups, _ := letmein.GetGroups(ctx, client, []string{"all"}, "group-name")
+	perms := groups[0].IpPermissions
+	if len(perms) != 1 || aws.Int64Value(perms[0].FromPort) != -1 || aws.Int64Value(perms[0].ToPort) != -1 {
+		t.Errorf("unexpected permissions: %v", perms)
+	}
+	if err := letmein.CleanGroups(ctx, client, groups); err != nil {
+		t.Fatal(err)
+	}
+	if rules := sim.State().Groups[0].Ingress; len(rules) != 0 {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+}
+
+func TestAuthorizeRevoke(t *testing.T) {
+	client, sim, done := newClient(t, seed(), 0)
+	defer done()
+	ctx := context.Background()
+
+	input := letmein.Input{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(22),
+		ToPort:     aws.Int64(22),
+		CidrIp:     aws.String("1.2.3.4/32"),
+	}
+	groups, _ := letmein.GetGroups(ctx, client, []string{"db"}, "group-name")
+
+	// duplicate error is ignored by letmein
+	for i := 0; i < 2; i++ {
+		if err := letmein.AuthorizeGroups(ctx, client, groups, input); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rules := sim.State().Groups[1].Ingress; len(rules) != 1 || rules[0].Cidrs[0] != "1.2.3.4/32" {
+		t.Errorf("unexpected rules: %v", rules)
+	}
+
+	_, err := client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String("sg-2"), IpProtocol: input.IpProtocol, FromPort: input.FromPort, ToPort: input.ToPort, CidrIp: input.CidrIp,
+	})
+	if code(err) != "InvalidPermission.Duplicate" {
+		t.Errorf("expected InvalidPermission.Duplicate, got %v", err)
+	}
+
+	// not found error is ignored by letmein
+	for i := 0; i < 2; i++ {
+		if err := letmein.RevokeGroups(ctx, client, groups, input); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rules := sim.State().Groups[1].Ingress; len(rules) != 0 {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+
+	// the same range twice in one request is removed once
+	letmein.AuthorizeGroups(ctx, client, groups, input)
+	perm := &ec2.IpPermission{IpProtocol: input.IpProtocol, FromPort: input.FromPort, ToPort: input.ToPort,
+		IpRanges: []*ec2.IpRange{{CidrIp: input.CidrIp}, {CidrIp: input.CidrIp}}}
+	if _, err := client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId: aws.String("sg-2"), IpPermissions: []*ec2.IpPermission{perm},
+	}); err != nil {
+		t.Errorf("expected duplicate ranges revoked, got %v", err)
+	}
+	if rules := sim.State().Groups[1].Ingress; len(rules) != 0 {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+
+	// clean removes rules with several cidrs
+	groups, _ = letmein.GetGroups(ctx, client, []string{"web"}, "group-name")
+	if err := letmein.CleanGroups(ctx, client, groups); err != nil {
+		t.Fatal(err)
+	}
+	if rules := sim.State().Groups[0].Ingress; len(rules) != 0 {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	client, sim, done := newClient(t, seed(), 0)
+	defer done()
+	ctx := context.Background()
+
+	groups, _ := letmein.GetGroups(ctx, client, []string{"db"}, "group-name")
+	if err := letmein.AuthorizeGroups(ctx, client, groups, letmein.NewInput("tcp", 22, "1.2.3.4/32")); err != nil {
+		t.Fatal(err)
+	}
+	if err := letmein.AuthorizeGroups(ctx, client, groups, letmein.NewInput("tcp", 22, "2001:db8::1/128")); err != nil {
+		t.Fatal(err)
+	}
+
+	groups, _ = letmein.GetGroups(ctx, client, []string{"db"}, "group-name")
+	perm := groups[0].IpPermissions[0]
+	if len(perm.IpRanges) != 1 || len(perm.Ipv6Ranges) != 1 || *perm.Ipv6Ranges[0].CidrIpv6 != "2001:db8::1/128" {
+		t.Errorf("expected one range of each family, got %v", perm)
+	}
+
+	// IPv6 blocks are not allowed as CidrIp
+	_, err := client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String("sg-2"), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(22), ToPort: aws.Int64(22), CidrIp: aws.String("2001:db8::2/128"),
+	})
+	if code(err) != "InvalidParameterValue" {
+		t.Errorf("expected InvalidParameterValue, got %v", err)
+	}
+
+	if err := letmein.CleanGroups(ctx, client, groups); err != nil {
+		t.Fatal(err)
+	}
+	if rules := sim.State().Groups[1].Ingress; len(rules) != 0 {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+}
+
+func TestDescriptions(t *testing.T) {
+	client, sim, done := newClient(t, seed(), 0)
+	defer done()
+	ctx := context.Background()
+
+	groups, _ := letmein.GetGroups(ctx, client, []string{"db"}, "group-name")
+	input := letmein.NewInput("tcp", 22, "1.2.3.4/32")
+	input.Description = aws.String(letmein.Description)
+	if err := letmein.AuthorizeGroups(ctx, client, groups, input); err != nil {
+		t.Fatal(err)
+	}
+
+	groups, _ = letmein.GetGroups(ctx, client, []string{"db"}, "group-name")
+	perm := groups[0].IpPermissions[0]
+	if !letmein.IsLetMeIn(letmein.RangeDescription(perm, "1.2.3.4/32")) {
+		t.Errorf("expected let-me-in description, got %v", perm)
+	}
+	if d := sim.State().Groups[1].Ingress[0].Descriptions["1.2.3.4/32"]; d != letmein.Description {
+		t.Errorf("expected description in state, got %q", d)
+	}
+
+	// same rule with another description is a duplicate, and revoked without one
+	input.Description = aws.String("other")
+	if err := letmein.AuthorizeGroups(ctx, client, groups, input); err != nil {
+		t.Fatal(err)
+	}
+	if err := letmein.RevokeGroups(ctx, client, groups, input); err != nil {
+		t.Fatal(err)
+	}
+	if rules := sim.State().Groups[1].Ingress; len(rules) != 0 {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+}
+
+func TestAPIVersion(t *testing.T) {
+	// descriptions and IPv6 ranges need the version the sdk is built for
+	for _, test := range []struct {
+		version, param, value string
+		status                int
+	}{
+		{"2015-04-15", "IpPermissions.1.IpRanges.1.Description", "web", http.StatusBadRequest},
+		{"2015-04-15", "IpPermissions.1.Ipv6Ranges.1.CidrIpv6", "2001:db8::1/128", http.StatusBadRequest},
+		{"2015-04-15", "IpPermissions.1.IpRanges.2.CidrIp", "1.2.3.5/32", http.StatusOK},
+		{APIVersion, "IpPermissions.1.IpRanges.1.Description", "web", http.StatusOK},
+	} {
+		sim, err := New(seed())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ts := httptest.NewServer(sim)
+		resp, err := http.PostForm(ts.URL, url.Values{
+			"Action": {"AuthorizeSecurityGroupIngress"}, "Version": {test.version}, "GroupId": {"sg-1"},
+			"IpPermissions.1.IpProtocol": {"tcp"}, "IpPermissions.1.FromPort": {"22"}, "IpPermissions.1.ToPort": {"22"},
+			"IpPermissions.1.IpRanges.1.CidrIp": {"1.2.3.4/32"}, test.param: {test.value},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		ts.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%v %v: expected status %v, got %v", test.version, test.param, test.status, resp.StatusCode)
+		}
+	}
+
+	if version := ec2.New(session.Must(session.NewSession())).APIVersion; version < APIVersion {
+		t.Errorf("vendored sdk speaks EC2 API %v, before %v", version, APIVersion)
+	}
+}
+
+func TestEgressAndTags(t *testing.T) {
+	client, sim, done := newClient(t, seed(), 0)
+	defer done()
+
+	_, err := client.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId: aws.String("sg-1"),
+		IpPermissions: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("-1"),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if egress := sim.State().Groups[0].Egress; len(egress) != 1 || egress[0].Protocol != "-1" {
+		t.Errorf("unexpected egress: %v", egress)
+	}
+
+	_, err = client.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId:    aws.String("sg-1"),
+		IpProtocol: aws.String("-1"),
+		CidrIp:     aws.String("0.0.0.0/0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if egress := sim.State().Groups[0].Egress; len(egress) != 0 {
+		t.Errorf("expected no egress, got %v", egress)
+	}
+
+	_, err = client.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String("sg-1"), aws.String("sg-2")},
+		Tags:      []*ec2.Tag{{Key: aws.String("owner"), Value: aws.String("ops")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range sim.State().Groups {
+		if g.Tags["owner"] != "ops" {
+			t.Errorf("%v not tagged: %v", g.Id, g.Tags)
+		}
+	}
+}
+
+func TestFaults(t *testing.T) {
+	state := seed()
+	state.RuleLimit = 3
+	state.Faults = []*Fault{
+		{Action: "DescribeSecurityGroups", Code: "RequestLimitExceeded", Count: 2},
+	}
+	ctx := context.Background()
+
+	// throttled twice, so succeeds on third attempt
+	client, _, done := newClient(t, state, 3)
+	groups, err := letmein.GetGroups(ctx, client, []string{"web"}, "group-name")
+	done()
+	if err != nil {
+		t.Fatalf("expected retries to succeed, got %v", err)
+	}
+
+	// without retries we see the error
+	client, _, done = newClient(t, state, 0)
+	defer done()
+	if _, err := letmein.GetGroups(ctx, client, []string{"web"}, "group-name"); code(err) != "RequestLimitExceeded" {
+		t.Errorf("expected RequestLimitExceeded, got %v", err)
+	}
+
+	// web has 3 rules already
+	err = letmein.AuthorizeGroups(ctx, client, groups, letmein.Input{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(22),
+		ToPort:     aws.Int64(22),
+		CidrIp:     aws.String("1.2.3.4/32"),
+	})
+	if code(err) != "RulesPerSecurityGroupLimitExceeded" {
+		t.Errorf("expected RulesPerSecurityGroupLimitExceeded, got %v", err)
+	}
+}
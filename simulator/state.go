@@ -0,0 +1,62 @@
+package simulator
+
+import (
+	"fmt"
+	"github.com/rlister/let-me-in/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"io/ioutil"
+)
+
+// State is the initial contents of the simulator, as read from YAML.
+type State struct {
+	Groups    []*Group `yaml:"groups"`
+	Faults    []*Fault `yaml:"errors"`
+	RuleLimit int      `yaml:"rule_limit"` // max cidrs per group and direction, default 60
+}
+
+// Group is a security group and its rules.
+type Group struct {
+	Id          string            `yaml:"id"`
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	VpcId       string            `yaml:"vpc_id"`
+	Tags        map[string]string `yaml:"tags"`
+	Ingress     []*Rule           `yaml:"ingress"`
+	Egress      []*Rule           `yaml:"egress"`
+}
+
+// Rule allows a protocol and port range from a list of cidrs. Port may be
+// given instead of From and To for a single port. Descriptions of rules
+// are given by cidr.
+type Rule struct {
+	Protocol     string            `yaml:"protocol"`
+	Port         int64             `yaml:"port"`
+	From         int64             `yaml:"from"`
+	To           int64             `yaml:"to"`
+	Cidrs        []string          `yaml:"cidrs"`
+	Descriptions map[string]string `yaml:"descriptions,omitempty"`
+}
+
+// Fault is an error to return instead of performing an action.
+type Fault struct {
+	Action  string `yaml:"action"`  // api action to fail, or empty for all
+	GroupId string `yaml:"group"`   // only fail requests for this group
+	Code    string `yaml:"code"`    // aws error code, e.g. RequestLimitExceeded
+	Message string `yaml:"message"` // defaults to code
+	Status  int    `yaml:"status"`  // http status, default 503 for throttling and 400 otherwise
+	Count   int    `yaml:"count"`   // number of times to fail, or 0 for every time
+}
+
+// Load reads simulator state from a YAML file.
+func Load(path string) (*State, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{}
+	if err := yaml.UnmarshalStrict(data, state); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	return state, nil
+}
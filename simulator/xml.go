@@ -0,0 +1,107 @@
+package simulator
+
+import (
+	"encoding/xml"
+	"sort"
+	"strings"
+)
+
+// responses in the shapes expected by the ec2query protocol
+
+type xmlResponse struct {
+	XMLName   xml.Name
+	Xmlns     string      `xml:"xmlns,attr"`
+	RequestId string      `xml:"requestId"`
+	Body      interface{} `xml:",any"`
+}
+
+type xmlErrorResponse struct {
+	XMLName   xml.Name `xml:"Response"`
+	Code      string   `xml:"Errors>Error>Code"`
+	Message   string   `xml:"Errors>Error>Message"`
+	RequestId string   `xml:"RequestId"`
+}
+
+type xmlReturn struct {
+	XMLName xml.Name `xml:"return"`
+	Return  bool     `xml:",chardata"`
+}
+
+type xmlDescribeSecurityGroups struct {
+	XMLName xml.Name    `xml:"securityGroupInfo"`
+	Groups  []*xmlGroup `xml:"item"`
+}
+
+type xmlGroup struct {
+	OwnerId             string     `xml:"ownerId"`
+	GroupId             string     `xml:"groupId"`
+	GroupName           string     `xml:"groupName"`
+	Description         string     `xml:"groupDescription"`
+	VpcId               string     `xml:"vpcId,omitempty"`
+	IpPermissions       []*xmlPerm `xml:"ipPermissions>item"`
+	IpPermissionsEgress []*xmlPerm `xml:"ipPermissionsEgress>item"`
+	Tags                []*xmlTag  `xml:"tagSet>item"`
+}
+
+type xmlPerm struct {
+	IpProtocol string       `xml:"ipProtocol"`
+	FromPort   *int64       `xml:"fromPort,omitempty"`
+	ToPort     *int64       `xml:"toPort,omitempty"`
+	IpRanges   []*xmlRange  `xml:"ipRanges>item"`
+	Ipv6Ranges []*xmlRange6 `xml:"ipv6Ranges>item"`
+}
+
+type xmlRange struct {
+	CidrIp      string `xml:"cidrIp"`
+	Description string `xml:"description,omitempty"`
+}
+
+type xmlRange6 struct {
+	CidrIpv6    string `xml:"cidrIpv6"`
+	Description string `xml:"description,omitempty"`
+}
+
+type xmlTag struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func (g *group) xml() *xmlGroup {
+	x := &xmlGroup{
+		OwnerId:             "123456789012",
+		GroupId:             g.id,
+		GroupName:           g.name,
+		Description:         g.description,
+		VpcId:               g.vpcId,
+		IpPermissions:       xmlPerms(g.ingress),
+		IpPermissionsEgress: xmlPerms(g.egress),
+	}
+
+	keys := []string{}
+	for k := range g.tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		x.Tags = append(x.Tags, &xmlTag{k, g.tags[k]})
+	}
+
+	return x
+}
+
+func xmlPerms(entries []entry) []*xmlPerm {
+	perms := []*xmlPerm{}
+	for _, rule := range unflatten(entries) {
+		from, to := rule.From, rule.To
+		perm := &xmlPerm{IpProtocol: rule.Protocol, FromPort: &from, ToPort: &to}
+		for _, cidr := range rule.Cidrs {
+			if strings.Contains(cidr, ":") {
+				perm.Ipv6Ranges = append(perm.Ipv6Ranges, &xmlRange6{cidr, rule.Descriptions[cidr]})
+			} else {
+				perm.IpRanges = append(perm.IpRanges, &xmlRange{cidr, rule.Descriptions[cidr]})
+			}
+		}
+		perms = append(perms, perm)
+	}
+	return perms
+}